@@ -15,6 +15,7 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"fmt"
 	"log/slog"
@@ -60,14 +61,12 @@ func main() {
 	logging.Configure()
 	logger := logging.GetLogger()
 
-	// Test node connection
+	// Test node connection and warm up the connection pool
 	if cfg.Node.SkipCheck {
 		logger.Debug("skipping node check")
 	} else {
-		if oConn, err := node.GetConnection(nil); err != nil {
+		if err := node.GetPool().Warm(); err != nil {
 			logger.Error("failed to connect to node:", "error", err)
-		} else {
-			oConn.Close()
 		}
 	}
 
@@ -109,6 +108,15 @@ func main() {
 		}()
 	}
 
+	// Start upstream node health checks
+	node.GetUpstreams().Start(
+		context.Background(),
+		time.Duration(cfg.Node.UpstreamCheckInterval)*time.Second,
+	)
+
+	// Keep the block and transaction indexes up to date
+	node.StartChainIndexer(context.Background())
+
 	// Start API listener
 	go func() {
 		if err := api.Start(cfg); err != nil {
@@ -23,6 +23,29 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
+        "/admin/upstreams": {
+            "get": {
+                "description": "Report the health of each configured upstream cardano-node. New connections are routed to the preferred upstream.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Get upstream node status",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/api.responseAdminUpstream"
+                            }
+                        }
+                    }
+                }
+            }
+        },
         "/chainsync/sync": {
             "get": {
                 "tags": [
@@ -68,6 +91,93 @@ const docTemplate = `{
                 }
             }
         },
+        "/localstatequery/accounts": {
+            "post": {
+                "description": "Get the reward balances and pool and DRep delegations of a batch of stake addresses. Accounts are returned in the order requested.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "localstatequery"
+                ],
+                "summary": "Query Accounts",
+                "parameters": [
+                    {
+                        "description": "Stake addresses (bech32)",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.requestLocalStateQueryAccounts"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.responseLocalStateQueryAccounts"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.responseApiError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.responseApiError"
+                        }
+                    }
+                }
+            }
+        },
+        "/localstatequery/accounts/{stake_address}": {
+            "get": {
+                "description": "Get the reward balance and pool and DRep delegations of a stake address",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "localstatequery"
+                ],
+                "summary": "Query Account",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Stake address (bech32)",
+                        "name": "stake_address",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.accountItem"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.responseApiError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.responseApiError"
+                        }
+                    }
+                }
+            }
+        },
         "/localstatequery/current-era": {
             "get": {
                 "produces": [
@@ -95,6 +205,7 @@ const docTemplate = `{
         },
         "/localstatequery/era-history": {
             "get": {
+                "description": "Query the era history. The end of the last era is the point up to which slot and time conversions are known to be stable.",
                 "produces": [
                     "application/json"
                 ],
@@ -120,6 +231,7 @@ const docTemplate = `{
         },
         "/localstatequery/genesis-config": {
             "get": {
+                "description": "Query the Shelley genesis config",
                 "produces": [
                     "application/json"
                 ],
@@ -143,20 +255,21 @@ const docTemplate = `{
                 }
             }
         },
-        "/localstatequery/protocol-params": {
+        "/localstatequery/governance/committee": {
             "get": {
+                "description": "Get the constitutional committee members and their hot credential authorizations, along with the committee voting threshold. Requires the Conway era or later.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "localstatequery"
                 ],
-                "summary": "Query Current Protocol Parameters",
+                "summary": "Query Constitutional Committee",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/api.responseLocalStateQueryProtocolParams"
+                            "$ref": "#/definitions/api.responseLocalStateQueryCommittee"
                         }
                     },
                     "500": {
@@ -168,20 +281,21 @@ const docTemplate = `{
                 }
             }
         },
-        "/localstatequery/system-start": {
+        "/localstatequery/governance/constitution": {
             "get": {
+                "description": "Get the current constitution anchor and guardrails script hash. Requires the Conway era or later.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "localstatequery"
                 ],
-                "summary": "Query System Start",
+                "summary": "Query Constitution",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/api.responseLocalStateQuerySystemStart"
+                            "$ref": "#/definitions/api.responseLocalStateQueryConstitution"
                         }
                     },
                     "500": {
@@ -193,20 +307,46 @@ const docTemplate = `{
                 }
             }
         },
-        "/localstatequery/tip": {
+        "/localstatequery/governance/dreps": {
             "get": {
+                "description": "Get the registered DReps and the voting stake delegated to them, ordered by DRep ID. Requires the Conway era or later.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "localstatequery"
                 ],
-                "summary": "Query Chain Tip",
+                "summary": "Query DReps",
+                "parameters": [
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 1000,
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 100,
+                        "description": "Page size",
+                        "name": "page_size",
+                        "in": "query"
+                    }
+                ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/api.responseLocalStateQueryTip"
+                            "$ref": "#/definitions/api.responseLocalStateQueryDreps"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.responseApiError"
                         }
                     },
                     "500": {
@@ -218,35 +358,32 @@ const docTemplate = `{
                 }
             }
         },
-        "/localstatequery/utxos/search-by-asset": {
+        "/localstatequery/governance/proposals": {
             "get": {
-                "description": "Search UTxOs by asset. The address parameter is required when API_MAX_UTXO_SEARCH_RESULTS is unset or \u003c= 0.",
+                "description": "Get the active governance proposals and their vote tallies, ordered by action ID. Requires the Conway era or later.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "localstatequery"
                 ],
-                "summary": "Search UTxOs by Asset",
+                "summary": "Query Governance Proposals",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Policy ID (hex)",
-                        "name": "policy_id",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Asset name (hex), empty string for unnamed assets",
-                        "name": "asset_name",
-                        "in": "query",
-                        "required": true
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
                     },
                     {
-                        "type": "string",
-                        "description": "Optional: Filter by address. The address parameter is required when API_MAX_UTXO_SEARCH_RESULTS is unset or \u003c= 0.",
-                        "name": "address",
+                        "maximum": 1000,
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 100,
+                        "description": "Page size",
+                        "name": "page_size",
                         "in": "query"
                     }
                 ],
@@ -254,7 +391,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/api.responseLocalStateQuerySearchUTxOsByAsset"
+                            "$ref": "#/definitions/api.responseLocalStateQueryProposals"
                         }
                     },
                     "400": {
@@ -272,32 +409,49 @@ const docTemplate = `{
                 }
             }
         },
-        "/localtxmonitor/has_tx/{tx_hash}": {
+        "/localstatequery/governance/proposals/{tx_hash}/{index}": {
             "get": {
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Get an active governance proposal along with the individual votes cast on it. Requires the Conway era or later.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "localtxmonitor"
+                    "localstatequery"
                 ],
-                "summary": "Check if a particular TX exists in the mempool",
+                "summary": "Query Governance Proposal",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Transaction hash (hex string)",
+                        "description": "Transaction hash of the proposal",
                         "name": "tx_hash",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Index of the proposal within the transaction",
+                        "name": "index",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/api.responseLocalTxMonitorHasTx"
+                            "$ref": "#/definitions/api.govProposalItem"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.responseApiError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api.responseApiError"
                         }
                     },
                     "500": {
@@ -309,23 +463,46 @@ const docTemplate = `{
                 }
             }
         },
-        "/localtxmonitor/sizes": {
+        "/localstatequery/pools": {
             "get": {
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Get the IDs of all registered stake pools, ordered by pool ID",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "localtxmonitor"
+                    "localstatequery"
+                ],
+                "summary": "Query Stake Pools",
+                "parameters": [
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 1000,
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 100,
+                        "description": "Page size",
+                        "name": "page_size",
+                        "in": "query"
+                    }
                 ],
-                "summary": "Get mempool capacity, size, and TX count",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/api.responseLocalTxMonitorSizes"
+                            "$ref": "#/definitions/api.responseLocalStateQueryPools"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.responseApiError"
                         }
                     },
                     "500": {
@@ -337,26 +514,46 @@ const docTemplate = `{
                 }
             }
         },
-        "/localtxmonitor/txs": {
+        "/localstatequery/pools/retiring": {
             "get": {
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Get the stake pools which are scheduled to retire and the epoch of their retirement, ordered by pool ID",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "localtxmonitor"
+                    "localstatequery"
+                ],
+                "summary": "Query Pool Retirements",
+                "parameters": [
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 1000,
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 100,
+                        "description": "Page size",
+                        "name": "page_size",
+                        "in": "query"
+                    }
                 ],
-                "summary": "List all transactions in the mempool",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/api.responseLocalTxMonitorTxs"
-                            }
+                            "$ref": "#/definitions/api.responseLocalStateQueryPoolRetirements"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.responseApiError"
                         }
                     },
                     "500": {
@@ -368,86 +565,1675 @@ const docTemplate = `{
                 }
             }
         },
-        "/localtxsubmission/tx": {
-            "post": {
-                "description": "Submit an already serialized transaction to the network.",
+        "/localstatequery/pools/{pool_id}": {
+            "get": {
+                "description": "Get the registered parameters of a stake pool, including its pending retirement epoch if any",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "localtxsubmission"
+                    "localstatequery"
                 ],
-                "summary": "Submit Tx",
+                "summary": "Query Stake Pool Parameters",
                 "parameters": [
                     {
-                        "enum": [
-                            "application/cbor"
-                        ],
                         "type": "string",
-                        "description": "Content type",
-                        "name": "Content-Type",
-                        "in": "header",
+                        "description": "Pool ID (bech32 or hex)",
+                        "name": "pool_id",
+                        "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "202": {
-                        "description": "Ok",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/api.responseLocalStateQueryPoolParams"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/api.responseApiError"
                         }
                     },
-                    "415": {
-                        "description": "Unsupported Media Type",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/api.responseApiError"
                         }
                     },
                     "500": {
-                        "description": "Server Error",
+                        "description": "Internal Server Error",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/api.responseApiError"
                         }
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "api.responseApiError": {
-            "type": "object",
-            "properties": {
-                "msg": {
-                    "type": "string",
-                    "example": "error message"
-                }
-            }
         },
-        "api.responseLocalStateQueryCurrentEra": {
-            "type": "object",
-            "properties": {
-                "id": {
-                    "type": "integer"
+        "/localstatequery/protocol-params": {
+            "get": {
+                "description": "Query the protocol parameters for the current era. Alonzo and later eras are supported, and fields which do not exist in the current era are omitted.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "localstatequery"
+                ],
+                "summary": "Query Current Protocol Parameters",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.responseLocalStateQueryProtocolParams"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.responseApiError"
+                        }
+                    }
+                }
+            }
+        },
+        "/localstatequery/slot-to-epoch": {
+            "get": {
+                "description": "Get the epoch containing a slot and the slot's offset within that epoch using the era history",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "localstatequery"
+                ],
+                "summary": "Convert Slot to Epoch",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "slot number",
+                        "name": "slot",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.responseLocalStateQuerySlotToEpoch"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.responseApiError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.responseApiError"
+                        }
+                    }
+                }
+            }
+        },
+        "/localstatequery/slot-to-time": {
+            "get": {
+                "description": "Convert a slot number to the wall-clock time at the start of the slot using the era history",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "localstatequery"
+                ],
+                "summary": "Convert Slot to Time",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "slot number",
+                        "name": "slot",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.responseLocalStateQuerySlotToTime"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.responseApiError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.responseApiError"
+                        }
+                    }
+                }
+            }
+        },
+        "/localstatequery/stake-distribution": {
+            "get": {
+                "description": "Get the relative stake of each registered pool, ordered by pool ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "localstatequery"
+                ],
+                "summary": "Query Stake Distribution",
+                "parameters": [
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 1000,
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 100,
+                        "description": "Page size",
+                        "name": "page_size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.responseLocalStateQueryStakeDistribution"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.responseApiError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.responseApiError"
+                        }
+                    }
+                }
+            }
+        },
+        "/localstatequery/system-start": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "localstatequery"
+                ],
+                "summary": "Query System Start",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.responseLocalStateQuerySystemStart"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.responseApiError"
+                        }
+                    }
+                }
+            }
+        },
+        "/localstatequery/time-to-slot": {
+            "get": {
+                "description": "Convert a POSIX time in milliseconds to the slot containing it using the era history",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "localstatequery"
+                ],
+                "summary": "Convert Time to Slot",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "POSIX time in milliseconds",
+                        "name": "posix_time",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.responseLocalStateQueryTimeToSlot"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.responseApiError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.responseApiError"
+                        }
+                    }
+                }
+            }
+        },
+        "/localstatequery/tip": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "localstatequery"
+                ],
+                "summary": "Query Chain Tip",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.responseLocalStateQueryTip"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.responseApiError"
+                        }
+                    }
+                }
+            }
+        },
+        "/localstatequery/utxos/by-address": {
+            "get": {
+                "description": "Query the UTxOs at one or more addresses. Addresses may be provided in bech32 or hex form.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "localstatequery"
+                ],
+                "summary": "Query UTxOs by Address",
+                "parameters": [
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Address (bech32 or hex), may be repeated",
+                        "name": "address",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.responseLocalStateQueryUTxOs"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.responseApiError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.responseApiError"
+                        }
+                    }
+                }
+            }
+        },
+        "/localstatequery/utxos/by-txin": {
+            "post": {
+                "description": "Resolve a batch of transaction inputs, each in the form \u003ctx hash\u003e#\u003cindex\u003e. Inputs which are not in the UTxO set are omitted from the response.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "localstatequery"
+                ],
+                "summary": "Query UTxOs by Transaction Input",
+                "parameters": [
+                    {
+                        "description": "Transaction inputs",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.requestLocalStateQueryUTxOsByTxIn"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.responseLocalStateQueryUTxOs"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.responseApiError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.responseApiError"
+                        }
+                    }
+                }
+            }
+        },
+        "/localstatequery/utxos/search-by-asset": {
+            "get": {
+                "description": "Search UTxOs by asset. The address parameter is required when API_MAX_UTXO_SEARCH_RESULTS is unset or \u003c= 0.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "localstatequery"
+                ],
+                "summary": "Search UTxOs by Asset",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Policy ID (hex)",
+                        "name": "policy_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Asset name (hex), empty string for unnamed assets",
+                        "name": "asset_name",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Optional: Filter by address. The address parameter is required when API_MAX_UTXO_SEARCH_RESULTS is unset or \u003c= 0.",
+                        "name": "address",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.responseLocalStateQuerySearchUTxOsByAsset"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.responseApiError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.responseApiError"
+                        }
+                    }
+                }
+            }
+        },
+        "/localtxmonitor/has_tx/{tx_hash}": {
+            "get": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "localtxmonitor"
+                ],
+                "summary": "Check if a particular TX exists in the mempool",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Transaction hash (hex string)",
+                        "name": "tx_hash",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.responseLocalTxMonitorHasTx"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.responseApiError"
+                        }
+                    }
+                }
+            }
+        },
+        "/localtxmonitor/sizes": {
+            "get": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "localtxmonitor"
+                ],
+                "summary": "Get mempool capacity, size, and TX count",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.responseLocalTxMonitorSizes"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.responseApiError"
+                        }
+                    }
+                }
+            }
+        },
+        "/localtxmonitor/txs": {
+            "get": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "localtxmonitor"
+                ],
+                "summary": "List all transactions in the mempool",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/api.responseLocalTxMonitorTxs"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.responseApiError"
+                        }
+                    }
+                }
+            }
+        },
+        "/localtxsubmission/tx": {
+            "post": {
+                "description": "Submit an already serialized transaction to the network. When validation is enabled, a transaction which breaks the phase-1 ledger rules is rejected with the list of failures and isn't sent to the node. When the node rejects the transaction, the decoded ledger failures are returned as the reason, or the raw CBOR reason when requested with an Accept header of application/cbor. When waiting, the transaction is tracked after submission and each stage it reaches is sent as a server-sent status event. The stages are accepted, mempool, included (with the block slot and hash), confirmed (with the number of blocks on top), rolled_back and dropped. The stream ends once the transaction has the requested number of confirmations or is dropped. If tracking fails, an error event is sent before the stream ends.",
+                "produces": [
+                    "application/json",
+                    "text/event-stream"
+                ],
+                "tags": [
+                    "localtxsubmission"
+                ],
+                "summary": "Submit Tx",
+                "parameters": [
+                    {
+                        "enum": [
+                            "application/cbor"
+                        ],
+                        "type": "string",
+                        "description": "Content type",
+                        "name": "Content-Type",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Validate the transaction before submitting it (default from config)",
+                        "name": "validate",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Stream the stages of the transaction after submitting it",
+                        "name": "wait",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of blocks on top of the including block to wait for (default 0)",
+                        "name": "confirmations",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Status events (when waiting)",
+                        "schema": {
+                            "$ref": "#/definitions/node.TxStatus"
+                        }
+                    },
+                    "202": {
+                        "description": "Ok",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.responseLocalTxSubmissionError"
+                        }
+                    },
+                    "415": {
+                        "description": "Unsupported Media Type",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Server Error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/localtxsubmission/txs": {
+            "post": {
+                "description": "Submit an ordered list of already serialized transactions (hex encoded CBOR) to the network over a single node connection. Later transactions may spend the outputs of earlier ones, so the transactions aren't validated before submission. After a failure, the remaining transactions are skipped unless continue_on_error is set. The hash and status of each transaction are returned in the order submitted.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "localtxsubmission"
+                ],
+                "summary": "Submit Tx Batch",
+                "parameters": [
+                    {
+                        "description": "Transactions (hex encoded CBOR)",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.requestLocalSubmitTxs"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.responseLocalSubmitTxs"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api.responseApiError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api.responseApiError"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "api.accountItem": {
+            "type": "object",
+            "properties": {
+                "delegated_drep": {
+                    "description": "DelegatedDrep is the CIP-0129 bech32 ID of the DRep the voting power is\ndelegated to, or one of drep_abstain/drep_no_confidence",
+                    "type": "string",
+                    "example": "drep_abstain"
+                },
+                "delegated_pool": {
+                    "description": "DelegatedPool is the bech32 ID of the pool the stake is delegated to",
+                    "type": "string",
+                    "example": "pool1pu5jlj4q9w9jlxeu370a3c9myx47md5j5m2str0naunn2q3lkdy"
+                },
+                "registered": {
+                    "type": "boolean"
+                },
+                "reward_balance": {
+                    "type": "integer",
+                    "format": "int64",
+                    "minimum": 0,
+                    "example": 1500000
+                },
+                "stake_address": {
+                    "type": "string",
+                    "example": "stake1u9ylzsgxaa6xctf4juup682ar3juj85n8tx3hthnljg47zctvm3rc"
+                }
+            }
+        },
+        "api.committeeMemberItem": {
+            "type": "object",
+            "properties": {
+                "adjusted_expiry_epoch": {
+                    "type": "integer",
+                    "example": 600
+                },
+                "cold_credential": {
+                    "description": "ColdCredential is the CIP-0129 bech32 ID of the member's cold credential",
+                    "type": "string"
+                },
+                "expiry_epoch": {
+                    "type": "integer",
+                    "example": 580
+                },
+                "hot_credential": {
+                    "description": "HotCredential is the CIP-0129 bech32 ID of the authorized hot\ncredential, if any",
+                    "type": "string"
+                },
+                "hot_credential_status": {
+                    "type": "string",
+                    "enum": [
+                        "not_authorized",
+                        "authorized",
+                        "resigned"
+                    ],
+                    "example": "authorized"
+                },
+                "next_epoch_change": {
+                    "type": "string",
+                    "enum": [
+                        "no_change",
+                        "to_be_enacted",
+                        "to_be_removed",
+                        "to_be_expired",
+                        "term_adjusted"
+                    ],
+                    "example": "no_change"
+                },
+                "resignation_anchor": {
+                    "$ref": "#/definitions/api.govAnchor"
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "active",
+                        "expired",
+                        "unrecognized"
+                    ],
+                    "example": "active"
+                }
+            }
+        },
+        "api.drepItem": {
+            "type": "object",
+            "properties": {
+                "anchor": {
+                    "$ref": "#/definitions/api.govAnchor"
+                },
+                "delegators": {
+                    "type": "integer",
+                    "example": 42
+                },
+                "deposit": {
+                    "type": "integer",
+                    "format": "int64",
+                    "minimum": 0,
+                    "example": 500000000
+                },
+                "drep_id": {
+                    "description": "DrepId is the CIP-0129 bech32 ID of the DRep",
+                    "type": "string"
+                },
+                "expiry_epoch": {
+                    "type": "integer",
+                    "example": 520
+                },
+                "stake": {
+                    "type": "integer",
+                    "format": "int64",
+                    "minimum": 0,
+                    "example": 1000000000000
+                }
+            }
+        },
+        "api.drepVotingThresholds": {
+            "type": "object",
+            "properties": {
+                "committee_no_confidence": {
+                    "$ref": "#/definitions/api.rational"
+                },
+                "committee_normal": {
+                    "$ref": "#/definitions/api.rational"
+                },
+                "hard_fork_initiation": {
+                    "$ref": "#/definitions/api.rational"
+                },
+                "motion_no_confidence": {
+                    "$ref": "#/definitions/api.rational"
+                },
+                "pp_economic_group": {
+                    "$ref": "#/definitions/api.rational"
+                },
+                "pp_gov_group": {
+                    "$ref": "#/definitions/api.rational"
+                },
+                "pp_network_group": {
+                    "$ref": "#/definitions/api.rational"
+                },
+                "pp_technical_group": {
+                    "$ref": "#/definitions/api.rational"
+                },
+                "treasury_withdrawal": {
+                    "$ref": "#/definitions/api.rational"
+                },
+                "update_to_constitution": {
+                    "$ref": "#/definitions/api.rational"
+                }
+            }
+        },
+        "api.eraBound": {
+            "type": "object",
+            "properties": {
+                "epoch": {
+                    "type": "integer",
+                    "example": 507
+                },
+                "posix_time": {
+                    "type": "integer",
+                    "format": "int64",
+                    "example": 1725227091000
+                },
+                "slot": {
+                    "type": "integer",
+                    "example": 133660800
+                },
+                "time": {
+                    "type": "string",
+                    "example": "2024-09-01T21:44:51Z"
+                }
+            }
+        },
+        "api.eraSummary": {
+            "type": "object",
+            "properties": {
+                "end": {
+                    "$ref": "#/definitions/api.eraBound"
+                },
+                "epoch_length": {
+                    "type": "integer",
+                    "example": 432000
+                },
+                "era": {
+                    "type": "string",
+                    "example": "Conway"
+                },
+                "safe_zone": {
+                    "type": "integer",
+                    "example": 129600
+                },
+                "slot_length_ms": {
+                    "type": "integer",
+                    "example": 1000
+                },
+                "start": {
+                    "$ref": "#/definitions/api.eraBound"
+                }
+            }
+        },
+        "api.exUnits": {
+            "type": "object",
+            "properties": {
+                "memory": {
+                    "type": "integer",
+                    "format": "int64",
+                    "example": 14000000
+                },
+                "steps": {
+                    "type": "integer",
+                    "format": "int64",
+                    "example": 10000000000
+                }
+            }
+        },
+        "api.executionPrices": {
+            "type": "object",
+            "properties": {
+                "memory": {
+                    "$ref": "#/definitions/api.rational"
+                },
+                "steps": {
+                    "$ref": "#/definitions/api.rational"
+                }
+            }
+        },
+        "api.govAnchor": {
+            "type": "object",
+            "properties": {
+                "data_hash": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string",
+                    "example": "https://example.com/anchor.json"
+                }
+            }
+        },
+        "api.govProposalItem": {
+            "type": "object",
+            "properties": {
+                "anchor": {
+                    "$ref": "#/definitions/api.govAnchor"
+                },
+                "deposit": {
+                    "type": "integer",
+                    "format": "int64",
+                    "minimum": 0,
+                    "example": 100000000000
+                },
+                "expires_after": {
+                    "type": "integer",
+                    "example": 506
+                },
+                "id": {
+                    "type": "string",
+                    "example": "9f6a2cd3c2a25e1f4a6ec0f8c8ec1e30d4a5e5d0b6a56cb4df0b7a6b2f23e8d1#0"
+                },
+                "prev_action_id": {
+                    "type": "string"
+                },
+                "proposed_in": {
+                    "type": "integer",
+                    "example": 500
+                },
+                "return_address": {
+                    "type": "string"
+                },
+                "tallies": {
+                    "$ref": "#/definitions/api.govVoteTallies"
+                },
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "parameter_change",
+                        "hard_fork_initiation",
+                        "treasury_withdrawals",
+                        "no_confidence",
+                        "update_committee",
+                        "new_constitution",
+                        "info"
+                    ],
+                    "example": "info"
+                },
+                "votes": {
+                    "description": "Votes is only populated when querying a single proposal",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.govVote"
+                    }
+                }
+            }
+        },
+        "api.govVote": {
+            "type": "object",
+            "properties": {
+                "vote": {
+                    "type": "string",
+                    "enum": [
+                        "yes",
+                        "no",
+                        "abstain"
+                    ],
+                    "example": "yes"
+                },
+                "voter": {
+                    "description": "Voter is the CIP-0129 bech32 ID of the voting committee hot credential\nor DRep, or the bech32 ID of the voting pool",
+                    "type": "string"
+                },
+                "voter_role": {
+                    "type": "string",
+                    "enum": [
+                        "committee",
+                        "drep",
+                        "spo"
+                    ],
+                    "example": "drep"
+                }
+            }
+        },
+        "api.govVoteTallies": {
+            "type": "object",
+            "properties": {
+                "committee": {
+                    "$ref": "#/definitions/api.voteTally"
+                },
+                "drep": {
+                    "$ref": "#/definitions/api.voteTally"
+                },
+                "spo": {
+                    "$ref": "#/definitions/api.voteTally"
+                }
+            }
+        },
+        "api.governanceParams": {
+            "type": "object",
+            "properties": {
+                "committee_stake_coverage": {
+                    "$ref": "#/definitions/api.rational"
+                },
+                "committee_term_limit": {
+                    "type": "integer",
+                    "example": 146
+                },
+                "drep_deposit": {
+                    "type": "integer",
+                    "format": "int64",
+                    "minimum": 0,
+                    "example": 500000000
+                },
+                "drep_inactivity_period": {
+                    "type": "integer",
+                    "example": 20
+                },
+                "drep_voting_thresholds": {
+                    "$ref": "#/definitions/api.drepVotingThresholds"
+                },
+                "gov_action_deposit": {
+                    "type": "integer",
+                    "format": "int64",
+                    "minimum": 0,
+                    "example": 100000000000
+                },
+                "gov_action_validity_period": {
+                    "type": "integer",
+                    "example": 6
+                },
+                "min_committee_size": {
+                    "type": "integer",
+                    "example": 7
+                },
+                "pool_voting_thresholds": {
+                    "$ref": "#/definitions/api.poolVotingThresholds"
+                },
+                "quorum_stake_threshold": {
+                    "$ref": "#/definitions/api.rational"
+                }
+            }
+        },
+        "api.poolMetadata": {
+            "type": "object",
+            "properties": {
+                "hash": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string",
+                    "example": "https://example.com/pool.json"
+                }
+            }
+        },
+        "api.poolRelay": {
+            "type": "object",
+            "properties": {
+                "hostname": {
+                    "type": "string",
+                    "example": "relay.example.com"
+                },
+                "ipv4": {
+                    "type": "string"
+                },
+                "ipv6": {
+                    "type": "string"
+                },
+                "port": {
+                    "type": "integer",
+                    "example": 3001
+                },
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "single_host_address",
+                        "single_host_name",
+                        "multi_host_name"
+                    ],
+                    "example": "single_host_name"
+                }
+            }
+        },
+        "api.poolRetirementItem": {
+            "type": "object",
+            "properties": {
+                "epoch": {
+                    "type": "integer",
+                    "format": "int64",
+                    "minimum": 0,
+                    "example": 500
+                },
+                "pool_id": {
+                    "type": "string",
+                    "example": "pool1pu5jlj4q9w9jlxeu370a3c9myx47md5j5m2str0naunn2q3lkdy"
+                }
+            }
+        },
+        "api.poolVotingThresholds": {
+            "type": "object",
+            "properties": {
+                "committee_no_confidence": {
+                    "$ref": "#/definitions/api.rational"
+                },
+                "committee_normal": {
+                    "$ref": "#/definitions/api.rational"
+                },
+                "hard_fork_initiation": {
+                    "$ref": "#/definitions/api.rational"
+                },
+                "motion_no_confidence": {
+                    "$ref": "#/definitions/api.rational"
+                },
+                "pp_security_group": {
+                    "$ref": "#/definitions/api.rational"
+                }
+            }
+        },
+        "api.protocolVersion": {
+            "type": "object",
+            "properties": {
+                "major": {
+                    "type": "integer",
+                    "example": 10
+                },
+                "minor": {
+                    "type": "integer",
+                    "example": 0
+                }
+            }
+        },
+        "api.rational": {
+            "type": "object",
+            "properties": {
+                "denominator": {
+                    "type": "integer",
+                    "format": "int64",
+                    "example": 10000
+                },
+                "numerator": {
+                    "type": "integer",
+                    "format": "int64",
+                    "example": 577
+                }
+            }
+        },
+        "api.referenceScriptFee": {
+            "type": "object",
+            "properties": {
+                "cost_multiplier": {
+                    "$ref": "#/definitions/api.rational"
+                },
+                "cost_stride": {
+                    "type": "integer",
+                    "example": 25600
+                },
+                "max_size_per_block": {
+                    "type": "integer",
+                    "example": 1048576
+                },
+                "max_size_per_tx": {
+                    "type": "integer",
+                    "example": 204800
+                },
+                "min_fee_cost_per_byte": {
+                    "$ref": "#/definitions/api.rational"
+                }
+            }
+        },
+        "api.requestLocalStateQueryAccounts": {
+            "type": "object",
+            "required": [
+                "stake_addresses"
+            ],
+            "properties": {
+                "stake_addresses": {
+                    "type": "array",
+                    "maxItems": 1000,
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "stake1u9ylzsgxaa6xctf4juup682ar3juj85n8tx3hthnljg47zctvm3rc"
+                    ]
+                }
+            }
+        },
+        "api.requestLocalStateQueryUTxOsByTxIn": {
+            "type": "object",
+            "required": [
+                "txins"
+            ],
+            "properties": {
+                "txins": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "9f6a2cd3c2a25e1f4a6ec0f8c8ec1e30d4a5e5d0b6a56cb4df0b7a6b2f23e8d1#0"
+                    ]
+                }
+            }
+        },
+        "api.requestLocalSubmitTxs": {
+            "type": "object",
+            "required": [
+                "transactions"
+            ],
+            "properties": {
+                "continue_on_error": {
+                    "type": "boolean"
+                },
+                "transactions": {
+                    "type": "array",
+                    "maxItems": 100,
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "84a400d9010281825820..."
+                    ]
+                }
+            }
+        },
+        "api.responseAdminUpstream": {
+            "type": "object",
+            "properties": {
+                "address": {
+                    "type": "string"
+                },
+                "last_check": {
+                    "type": "string"
+                },
+                "last_error": {
+                    "type": "string"
+                },
+                "latency_ms": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "preferred": {
+                    "type": "boolean"
+                },
+                "slot_lag": {
+                    "type": "integer"
+                },
+                "state": {
+                    "type": "string",
+                    "enum": [
+                        "healthy",
+                        "unknown",
+                        "lagging",
+                        "down"
+                    ]
+                },
+                "tip_block": {
+                    "type": "integer"
+                },
+                "tip_hash": {
+                    "type": "string"
+                },
+                "tip_slot": {
+                    "type": "integer"
+                },
+                "tip_updated": {
+                    "type": "string"
+                }
+            }
+        },
+        "api.responseApiError": {
+            "type": "object",
+            "properties": {
+                "msg": {
+                    "type": "string",
+                    "example": "error message"
+                }
+            }
+        },
+        "api.responseLocalStateQueryAccounts": {
+            "type": "object",
+            "properties": {
+                "accounts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.accountItem"
+                    }
+                }
+            }
+        },
+        "api.responseLocalStateQueryCommittee": {
+            "type": "object",
+            "properties": {
+                "epoch": {
+                    "type": "integer",
+                    "example": 510
+                },
+                "members": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.committeeMemberItem"
+                    }
+                },
+                "threshold": {
+                    "$ref": "#/definitions/api.rational"
+                }
+            }
+        },
+        "api.responseLocalStateQueryConstitution": {
+            "type": "object",
+            "properties": {
+                "anchor": {
+                    "$ref": "#/definitions/api.govAnchor"
+                },
+                "guardrails_script_hash": {
+                    "type": "string"
+                }
+            }
+        },
+        "api.responseLocalStateQueryCurrentEra": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "api.responseLocalStateQueryDreps": {
+            "type": "object",
+            "properties": {
+                "abstain_stake": {
+                    "description": "AbstainStake and NoConfidenceStake are the stake delegated to the\npredefined always-abstain and always-no-confidence options",
+                    "type": "integer",
+                    "format": "int64",
+                    "minimum": 0
+                },
+                "dreps": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.drepItem"
+                    }
+                },
+                "no_confidence_stake": {
+                    "type": "integer",
+                    "format": "int64",
+                    "minimum": 0
+                },
+                "page": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "page_size": {
+                    "type": "integer",
+                    "example": 100
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 3000
+                }
+            }
+        },
+        "api.responseLocalStateQueryEraHistory": {
+            "type": "object",
+            "properties": {
+                "eras": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.eraSummary"
+                    }
+                },
+                "system_start": {
+                    "type": "string",
+                    "example": "2017-09-23T21:44:51Z"
+                }
+            }
+        },
+        "api.responseLocalStateQueryGenesisConfig": {
+            "type": "object",
+            "properties": {
+                "active_slots_coeff": {
+                    "$ref": "#/definitions/api.rational"
+                },
+                "epoch_length": {
+                    "type": "integer",
+                    "example": 432000
+                },
+                "max_kes_evolutions": {
+                    "type": "integer",
+                    "example": 62
+                },
+                "max_lovelace_supply": {
+                    "type": "integer",
+                    "format": "int64",
+                    "minimum": 0,
+                    "example": 45000000000000000
+                },
+                "network_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "network_magic": {
+                    "type": "integer",
+                    "example": 764824073
+                },
+                "security_param": {
+                    "type": "integer",
+                    "example": 2160
+                },
+                "slot_length_ms": {
+                    "type": "integer",
+                    "example": 1000
+                },
+                "slots_per_kes_period": {
+                    "type": "integer",
+                    "example": 129600
+                },
+                "system_start": {
+                    "type": "string",
+                    "example": "2017-09-23T21:44:51Z"
+                },
+                "update_quorum": {
+                    "type": "integer",
+                    "example": 5
+                }
+            }
+        },
+        "api.responseLocalStateQueryPoolParams": {
+            "type": "object",
+            "properties": {
+                "cost": {
+                    "type": "integer",
+                    "format": "int64",
+                    "minimum": 0,
+                    "example": 340000000
+                },
+                "margin": {
+                    "$ref": "#/definitions/api.rational"
+                },
+                "metadata": {
+                    "$ref": "#/definitions/api.poolMetadata"
+                },
+                "owners": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "pledge": {
+                    "type": "integer",
+                    "format": "int64",
+                    "minimum": 0,
+                    "example": 100000000000
+                },
+                "pool_id": {
+                    "type": "string",
+                    "example": "pool1pu5jlj4q9w9jlxeu370a3c9myx47md5j5m2str0naunn2q3lkdy"
+                },
+                "relays": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.poolRelay"
+                    }
+                },
+                "retiring_epoch": {
+                    "type": "integer",
+                    "format": "int64",
+                    "minimum": 0,
+                    "example": 500
+                },
+                "reward_account": {
+                    "type": "string",
+                    "example": "stake1u9ylzsgxaa6xctf4juup682ar3juj85n8tx3hthnljg47zctvm3rc"
+                },
+                "vrf_key_hash": {
+                    "type": "string"
+                }
+            }
+        },
+        "api.responseLocalStateQueryPoolRetirements": {
+            "type": "object",
+            "properties": {
+                "page": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "page_size": {
+                    "type": "integer",
+                    "example": 100
+                },
+                "pools": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.poolRetirementItem"
+                    }
                 },
-                "name": {
-                    "type": "string"
+                "total": {
+                    "type": "integer",
+                    "example": 3000
                 }
             }
         },
-        "api.responseLocalStateQueryEraHistory": {
-            "type": "object"
+        "api.responseLocalStateQueryPools": {
+            "type": "object",
+            "properties": {
+                "page": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "page_size": {
+                    "type": "integer",
+                    "example": 100
+                },
+                "pools": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "pool1pu5jlj4q9w9jlxeu370a3c9myx47md5j5m2str0naunn2q3lkdy"
+                    ]
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 3000
+                }
+            }
         },
-        "api.responseLocalStateQueryGenesisConfig": {
-            "type": "object"
+        "api.responseLocalStateQueryProposals": {
+            "type": "object",
+            "properties": {
+                "page": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "page_size": {
+                    "type": "integer",
+                    "example": 100
+                },
+                "proposals": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.govProposalItem"
+                    }
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 3000
+                }
+            }
         },
         "api.responseLocalStateQueryProtocolParams": {
-            "type": "object"
+            "type": "object",
+            "properties": {
+                "coins_per_utxo_byte": {
+                    "type": "integer",
+                    "format": "int64",
+                    "minimum": 0,
+                    "example": 4310
+                },
+                "coins_per_utxo_word": {
+                    "type": "integer",
+                    "format": "int64",
+                    "minimum": 0
+                },
+                "collateral_percentage": {
+                    "type": "integer",
+                    "example": 150
+                },
+                "cost_models": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "array",
+                        "items": {
+                            "type": "integer",
+                            "format": "int64"
+                        }
+                    }
+                },
+                "decentralization": {
+                    "$ref": "#/definitions/api.rational"
+                },
+                "era": {
+                    "type": "string",
+                    "example": "Conway"
+                },
+                "execution_prices": {
+                    "$ref": "#/definitions/api.executionPrices"
+                },
+                "governance": {
+                    "$ref": "#/definitions/api.governanceParams"
+                },
+                "key_deposit": {
+                    "type": "integer",
+                    "format": "int64",
+                    "minimum": 0,
+                    "example": 2000000
+                },
+                "max_block_body_size": {
+                    "type": "integer",
+                    "example": 90112
+                },
+                "max_block_ex_units": {
+                    "$ref": "#/definitions/api.exUnits"
+                },
+                "max_block_header_size": {
+                    "type": "integer",
+                    "example": 1100
+                },
+                "max_collateral_inputs": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "max_epoch": {
+                    "type": "integer",
+                    "example": 18
+                },
+                "max_tx_ex_units": {
+                    "$ref": "#/definitions/api.exUnits"
+                },
+                "max_tx_size": {
+                    "type": "integer",
+                    "example": 16384
+                },
+                "max_value_size": {
+                    "type": "integer",
+                    "example": 5000
+                },
+                "min_fee_a": {
+                    "type": "integer",
+                    "example": 44
+                },
+                "min_fee_b": {
+                    "type": "integer",
+                    "example": 155381
+                },
+                "min_pool_cost": {
+                    "type": "integer",
+                    "format": "int64",
+                    "minimum": 0,
+                    "example": 170000000
+                },
+                "monetary_expansion": {
+                    "$ref": "#/definitions/api.rational"
+                },
+                "n_opt": {
+                    "type": "integer",
+                    "example": 500
+                },
+                "pool_deposit": {
+                    "type": "integer",
+                    "format": "int64",
+                    "minimum": 0,
+                    "example": 500000000
+                },
+                "pool_pledge_influence": {
+                    "$ref": "#/definitions/api.rational"
+                },
+                "protocol_version": {
+                    "$ref": "#/definitions/api.protocolVersion"
+                },
+                "reference_scripts": {
+                    "$ref": "#/definitions/api.referenceScriptFee"
+                },
+                "treasury_cut": {
+                    "$ref": "#/definitions/api.rational"
+                }
+            }
         },
         "api.responseLocalStateQuerySearchUTxOsByAsset": {
             "type": "object",
@@ -466,6 +2252,64 @@ const docTemplate = `{
                 }
             }
         },
+        "api.responseLocalStateQuerySlotToEpoch": {
+            "type": "object",
+            "properties": {
+                "epoch": {
+                    "type": "integer",
+                    "example": 507
+                },
+                "slot": {
+                    "type": "integer",
+                    "example": 133660800
+                },
+                "slot_in_epoch": {
+                    "type": "integer",
+                    "example": 0
+                }
+            }
+        },
+        "api.responseLocalStateQuerySlotToTime": {
+            "type": "object",
+            "properties": {
+                "posix_time": {
+                    "type": "integer",
+                    "format": "int64",
+                    "example": 1725227091000
+                },
+                "slot": {
+                    "type": "integer",
+                    "example": 133660800
+                },
+                "time": {
+                    "type": "string",
+                    "example": "2024-09-01T21:44:51Z"
+                }
+            }
+        },
+        "api.responseLocalStateQueryStakeDistribution": {
+            "type": "object",
+            "properties": {
+                "page": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "page_size": {
+                    "type": "integer",
+                    "example": 100
+                },
+                "pools": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.stakeDistributionItem"
+                    }
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 3000
+                }
+            }
+        },
         "api.responseLocalStateQuerySystemStart": {
             "type": "object",
             "properties": {
@@ -480,6 +2324,26 @@ const docTemplate = `{
                 }
             }
         },
+        "api.responseLocalStateQueryTimeToSlot": {
+            "type": "object",
+            "properties": {
+                "posix_time": {
+                    "type": "integer",
+                    "format": "int64",
+                    "example": 1725227091500
+                },
+                "slot": {
+                    "type": "integer",
+                    "example": 133660800
+                },
+                "slot_start": {
+                    "description": "SlotStart is the POSIX time at the start of the slot",
+                    "type": "integer",
+                    "format": "int64",
+                    "example": 1725227091000
+                }
+            }
+        },
         "api.responseLocalStateQueryTip": {
             "type": "object",
             "properties": {
@@ -500,6 +2364,31 @@ const docTemplate = `{
                 }
             }
         },
+        "api.responseLocalStateQueryUTxOs": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "utxos": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.utxoItem"
+                    }
+                }
+            }
+        },
+        "api.responseLocalSubmitTxs": {
+            "type": "object",
+            "properties": {
+                "results": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/node.TxSubmitResult"
+                    }
+                }
+            }
+        },
         "api.responseLocalTxMonitorHasTx": {
             "type": "object",
             "properties": {
@@ -537,6 +2426,39 @@ const docTemplate = `{
                 }
             }
         },
+        "api.responseLocalTxSubmissionError": {
+            "type": "object",
+            "properties": {
+                "failures": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/node.TxValidationFailure"
+                    }
+                },
+                "msg": {
+                    "type": "string",
+                    "example": "transaction rejected by node"
+                },
+                "reason": {
+                    "$ref": "#/definitions/node.TxRejectReason"
+                }
+            }
+        },
+        "api.stakeDistributionItem": {
+            "type": "object",
+            "properties": {
+                "pool_id": {
+                    "type": "string",
+                    "example": "pool1pu5jlj4q9w9jlxeu370a3c9myx47md5j5m2str0naunn2q3lkdy"
+                },
+                "stake_fraction": {
+                    "$ref": "#/definitions/api.rational"
+                },
+                "vrf_key_hash": {
+                    "type": "string"
+                }
+            }
+        },
         "api.utxoItem": {
             "type": "object",
             "properties": {
@@ -555,13 +2477,124 @@ const docTemplate = `{
                         "type": "object"
                     }
                 },
+                "datum": {
+                    "type": "string"
+                },
+                "datum_hash": {
+                    "type": "string"
+                },
                 "index": {
                     "type": "integer"
                 },
+                "reference_script": {
+                    "$ref": "#/definitions/api.utxoReferenceScript"
+                },
+                "tx_hash": {
+                    "type": "string"
+                }
+            }
+        },
+        "api.utxoReferenceScript": {
+            "type": "object",
+            "properties": {
+                "cbor": {
+                    "description": "Cbor contains the raw script bytes, hex encoded",
+                    "type": "string"
+                },
+                "hash": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string",
+                    "example": "PlutusV2"
+                }
+            }
+        },
+        "api.voteTally": {
+            "type": "object",
+            "properties": {
+                "abstain": {
+                    "type": "integer"
+                },
+                "no": {
+                    "type": "integer"
+                },
+                "yes": {
+                    "type": "integer"
+                }
+            }
+        },
+        "node.TxRejectReason": {
+            "type": "object",
+            "properties": {
+                "details": {
+                    "type": "object",
+                    "additionalProperties": true
+                },
+                "failures": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/node.TxRejectReason"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "node.TxStatus": {
+            "type": "object",
+            "properties": {
+                "block_hash": {
+                    "type": "string"
+                },
+                "block_number": {
+                    "type": "integer"
+                },
+                "confirmations": {
+                    "type": "integer"
+                },
+                "slot": {
+                    "type": "integer"
+                },
+                "stage": {
+                    "type": "string"
+                },
+                "tx_hash": {
+                    "type": "string"
+                }
+            }
+        },
+        "node.TxSubmitResult": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "reason": {
+                    "$ref": "#/definitions/node.TxRejectReason"
+                },
+                "status": {
+                    "type": "string"
+                },
                 "tx_hash": {
                     "type": "string"
                 }
             }
+        },
+        "node.TxValidationFailure": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                },
+                "rule": {
+                    "type": "string"
+                }
+            }
         }
     }
 }`
@@ -0,0 +1,165 @@
+// Copyright 2025 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"net/http"
+
+	"github.com/blinklabs-io/cardano-node-api/internal/node"
+	"github.com/blinklabs-io/gouroboros/ledger"
+	"github.com/gin-gonic/gin"
+)
+
+func configureAccountRoutes(group *gin.RouterGroup) {
+	group.GET("/accounts/:stake_address", handleLocalStateQueryAccount)
+	group.POST("/accounts", handleLocalStateQueryAccounts)
+}
+
+type accountItem struct {
+	StakeAddress  string `json:"stake_address"            example:"stake1u9ylzsgxaa6xctf4juup682ar3juj85n8tx3hthnljg47zctvm3rc"`
+	Registered    bool   `json:"registered"`
+	RewardBalance uint64 `json:"reward_balance"           example:"1500000"                                                     format:"int64" minimum:"0"`
+	// DelegatedPool is the bech32 ID of the pool the stake is delegated to
+	DelegatedPool string `json:"delegated_pool,omitempty" example:"pool1pu5jlj4q9w9jlxeu370a3c9myx47md5j5m2str0naunn2q3lkdy"`
+	// DelegatedDrep is the CIP-0129 bech32 ID of the DRep the voting power is
+	// delegated to, or one of drep_abstain/drep_no_confidence
+	DelegatedDrep string `json:"delegated_drep,omitempty" example:"drep_abstain"`
+}
+
+func newAccountItem(account node.Account) accountItem {
+	ret := accountItem{
+		StakeAddress:  account.StakeAddress.String(),
+		Registered:    account.Registered,
+		RewardBalance: account.RewardBalance,
+	}
+	if account.Pool != nil {
+		ret.DelegatedPool = account.Pool.String()
+	}
+	if account.Drep != nil {
+		ret.DelegatedDrep = account.Drep.String()
+	}
+	return ret
+}
+
+type requestLocalStateQueryAccount struct {
+	StakeAddress string `uri:"stake_address" binding:"required"`
+}
+
+// handleLocalStateQueryAccount godoc
+//
+//	@Summary		Query Account
+//	@Description	Get the reward balance and pool and DRep delegations of a stake address
+//	@Tags			localstatequery
+//	@Produce		json
+//	@Param			stake_address	path		string	true	"Stake address (bech32)"
+//	@Success		200				{object}	accountItem
+//	@Failure		400				{object}	responseApiError
+//	@Failure		500				{object}	responseApiError
+//	@Router			/localstatequery/accounts/{stake_address} [get]
+func handleLocalStateQueryAccount(c *gin.Context) {
+	var req requestLocalStateQueryAccount
+	if err := c.ShouldBindUri(&req); err != nil {
+		c.JSON(http.StatusBadRequest, apiError(err.Error()))
+		return
+	}
+	stakeAddress, err := node.ParseStakeAddress(req.StakeAddress)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, apiError(err.Error()))
+		return
+	}
+
+	// Lease a node connection from the pool
+	lease, err := node.AcquireConnection(c.Request.Context())
+	if err != nil {
+		c.JSON(500, apiError(err.Error()))
+		return
+	}
+	defer lease.Release()
+
+	// Get account
+	accounts, err := node.QueryAccounts(
+		lease.LocalStateQuery(),
+		[]ledger.Address{stakeAddress},
+	)
+	if err != nil {
+		c.JSON(500, apiError(err.Error()))
+		return
+	}
+
+	// Create response
+	resp := newAccountItem(accounts[0])
+	c.JSON(200, resp)
+}
+
+type requestLocalStateQueryAccounts struct {
+	StakeAddresses []string `json:"stake_addresses" binding:"required,min=1,max=1000" example:"stake1u9ylzsgxaa6xctf4juup682ar3juj85n8tx3hthnljg47zctvm3rc"`
+}
+
+type responseLocalStateQueryAccounts struct {
+	Accounts []accountItem `json:"accounts"`
+}
+
+// handleLocalStateQueryAccounts godoc
+//
+//	@Summary		Query Accounts
+//	@Description	Get the reward balances and pool and DRep delegations of a batch of stake addresses. Accounts are returned in the order requested.
+//	@Tags			localstatequery
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body		requestLocalStateQueryAccounts	true	"Stake addresses (bech32)"
+//	@Success		200		{object}	responseLocalStateQueryAccounts
+//	@Failure		400		{object}	responseApiError
+//	@Failure		500		{object}	responseApiError
+//	@Router			/localstatequery/accounts [post]
+func handleLocalStateQueryAccounts(c *gin.Context) {
+	var req requestLocalStateQueryAccounts
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, apiError(err.Error()))
+		return
+	}
+	stakeAddresses := make([]ledger.Address, 0, len(req.StakeAddresses))
+	for _, tmpStakeAddress := range req.StakeAddresses {
+		stakeAddress, err := node.ParseStakeAddress(tmpStakeAddress)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, apiError(err.Error()))
+			return
+		}
+		stakeAddresses = append(stakeAddresses, stakeAddress)
+	}
+
+	// Lease a node connection from the pool
+	lease, err := node.AcquireConnection(c.Request.Context())
+	if err != nil {
+		c.JSON(500, apiError(err.Error()))
+		return
+	}
+	defer lease.Release()
+
+	// Get accounts
+	accounts, err := node.QueryAccounts(lease.LocalStateQuery(), stakeAddresses)
+	if err != nil {
+		c.JSON(500, apiError(err.Error()))
+		return
+	}
+
+	// Create response
+	resp := responseLocalStateQueryAccounts{
+		Accounts: make([]accountItem, 0, len(accounts)),
+	}
+	for _, account := range accounts {
+		resp.Accounts = append(resp.Accounts, newAccountItem(account))
+	}
+	c.JSON(200, resp)
+}
@@ -0,0 +1,119 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/blinklabs-io/cardano-node-api/internal/node"
+	"github.com/blinklabs-io/gouroboros/ledger"
+	lcommon "github.com/blinklabs-io/gouroboros/ledger/common"
+	"github.com/gin-gonic/gin"
+)
+
+func TestNewAccountItem(t *testing.T) {
+	stakeAddress, err := ledger.NewAddressFromParts(
+		lcommon.AddressTypeNoneKey,
+		lcommon.AddressNetworkMainnet,
+		nil,
+		make([]byte, lcommon.AddressHashSize),
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	poolId := ledger.PoolId{0x01}
+
+	item := newAccountItem(node.Account{
+		StakeAddress:  stakeAddress,
+		Registered:    true,
+		RewardBalance: 1500000,
+		Pool:          &poolId,
+		Drep:          &lcommon.Drep{Type: lcommon.DrepTypeAbstain},
+	})
+
+	if item.StakeAddress != stakeAddress.String() ||
+		!strings.HasPrefix(item.StakeAddress, "stake1") {
+		t.Fatalf("unexpected stake address: %s", item.StakeAddress)
+	}
+	if !item.Registered || item.RewardBalance != 1500000 {
+		t.Fatalf("unexpected account state: %+v", item)
+	}
+	if item.DelegatedPool != poolId.String() {
+		t.Fatalf("unexpected delegated pool: %s", item.DelegatedPool)
+	}
+	if item.DelegatedDrep != "drep_abstain" {
+		t.Fatalf("unexpected delegated DRep: %s", item.DelegatedDrep)
+	}
+
+	item = newAccountItem(node.Account{StakeAddress: stakeAddress})
+	if item.Registered || item.DelegatedPool != "" ||
+		item.DelegatedDrep != "" {
+		t.Fatalf("unexpected unregistered account state: %+v", item)
+	}
+}
+
+func TestHandleLocalStateQueryAccountsValidation(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	configureAccountRoutes(router.Group("/localstatequery"))
+
+	testDefs := []struct {
+		method string
+		path   string
+		body   string
+	}{
+		{
+			method: http.MethodGet,
+			path:   "/localstatequery/accounts/stake1invalid",
+		},
+		{
+			// Payment addresses are rejected
+			method: http.MethodGet,
+			path:   "/localstatequery/accounts/addr1vx2fxv2umyhttkxyxp8x0dlpdt3k6cwng5pxj3jhsydzers66hrl8",
+		},
+		{
+			method: http.MethodPost,
+			path:   "/localstatequery/accounts",
+			body:   `{"stake_addresses":[]}`,
+		},
+		{
+			method: http.MethodPost,
+			path:   "/localstatequery/accounts",
+			body:   `{"stake_addresses":["stake1invalid"]}`,
+		},
+	}
+	for _, testDef := range testDefs {
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(
+			testDef.method,
+			testDef.path,
+			strings.NewReader(testDef.body),
+		)
+		req.Header.Set("Content-Type", "application/json")
+		router.ServeHTTP(w, req)
+		if w.Code != http.StatusBadRequest {
+			t.Fatalf(
+				"%s %s: expected status 400, got %d: %s",
+				testDef.method,
+				testDef.path,
+				w.Code,
+				w.Body.String(),
+			)
+		}
+	}
+}
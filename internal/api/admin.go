@@ -0,0 +1,75 @@
+// Copyright 2025 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"time"
+
+	"github.com/blinklabs-io/cardano-node-api/internal/node"
+	"github.com/gin-gonic/gin"
+)
+
+func configureAdminRoutes(apiGroup *gin.RouterGroup) {
+	group := apiGroup.Group("/admin")
+	group.GET("/upstreams", handleAdminUpstreams)
+}
+
+type responseAdminUpstream struct {
+	Name       string     `json:"name"`
+	Address    string     `json:"address"`
+	State      string     `json:"state"       enums:"healthy,unknown,lagging,down"`
+	Preferred  bool       `json:"preferred"`
+	TipSlot    uint64     `json:"tip_slot"`
+	TipBlock   uint64     `json:"tip_block"`
+	TipHash    string     `json:"tip_hash"`
+	SlotLag    uint64     `json:"slot_lag"`
+	LatencyMs  int64      `json:"latency_ms"`
+	LastCheck  *time.Time `json:"last_check,omitempty"`
+	TipUpdated *time.Time `json:"tip_updated,omitempty"`
+	LastError  string     `json:"last_error,omitempty"`
+}
+
+// handleAdminUpstreams godoc
+//
+//	@Summary		Get upstream node status
+//	@Description	Report the health of each configured upstream cardano-node. New connections are routed to the preferred upstream.
+//	@Tags			admin
+//	@Produce		json
+//	@Success		200	{array}	responseAdminUpstream
+//	@Router			/admin/upstreams [get]
+func handleAdminUpstreams(c *gin.Context) {
+	statuses := node.GetUpstreams().Status()
+	resp := make([]responseAdminUpstream, 0, len(statuses))
+	for _, status := range statuses {
+		resp = append(
+			resp,
+			responseAdminUpstream{
+				Name:       status.Name,
+				Address:    status.Address,
+				State:      status.State,
+				Preferred:  status.Preferred,
+				TipSlot:    status.TipSlot,
+				TipBlock:   status.TipBlock,
+				TipHash:    status.TipHash,
+				SlotLag:    status.SlotLag,
+				LatencyMs:  status.LatencyMs,
+				LastCheck:  status.LastCheck,
+				TipUpdated: status.TipUpdated,
+				LastError:  status.LastError,
+			},
+		)
+	}
+	c.JSON(200, resp)
+}
@@ -16,12 +16,15 @@ package api
 
 import (
 	"fmt"
+	"net/http"
 	"os"
 	"slices"
+	"time"
 
 	_ "github.com/blinklabs-io/cardano-node-api/docs" // docs is generated by Swag CLI
 	"github.com/blinklabs-io/cardano-node-api/internal/config"
 	"github.com/blinklabs-io/cardano-node-api/internal/logging"
+	"github.com/blinklabs-io/cardano-node-api/internal/node"
 	"github.com/gin-gonic/gin"
 	"github.com/penglongli/gin-metrics/ginmetrics"
 	swaggerFiles "github.com/swaggo/files"     // swagger embed files
@@ -66,7 +69,12 @@ func Start(cfg *config.Config) error {
 	accessLogger := logging.GetAccessLogger()
 	skipPaths := []string{}
 	if cfg.Logging.Healthchecks {
-		skipPaths = append(skipPaths, "/healthcheck")
+		skipPaths = append(
+			skipPaths,
+			"/healthcheck",
+			"/healthcheck/live",
+			"/healthcheck/ready",
+		)
 		logger.Info("disabling access logs for /healthcheck")
 	}
 	accessMiddleware := func(c *gin.Context) {
@@ -99,13 +107,17 @@ func Start(cfg *config.Config) error {
 	}
 	router.Use(accessMiddleware)
 
-	// Create a healthcheck
-	router.GET("/healthcheck", handleHealthcheck)
+	// Create healthchecks. The plain healthcheck reports readiness, for
+	// compatibility with existing probes.
+	router.GET("/healthcheck", handleHealthcheckReady)
+	router.GET("/healthcheck/live", handleHealthcheckLive)
+	router.GET("/healthcheck/ready", handleHealthcheckReady)
 	// Create a swagger endpoint
 	router.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
 
 	// Configure API routes
 	apiGroup := router.Group("/api")
+	configureAdminRoutes(apiGroup)
 	configureChainSyncRoutes(apiGroup)
 	configureLocalStateQueryRoutes(apiGroup)
 	configureLocalTxMonitorRoutes(apiGroup)
@@ -163,7 +175,72 @@ func apiError(msg string) responseApiError {
 	}
 }
 
-func handleHealthcheck(c *gin.Context) {
-	// TODO: add some actual health checking here
-	c.JSON(200, gin.H{"failed": false})
+type requestPagination struct {
+	Page     int `form:"page,default=1"        binding:"min=1"`
+	PageSize int `form:"page_size,default=100" binding:"min=1,max=1000"`
+}
+
+type responsePagination struct {
+	Page     int `json:"page"      example:"1"`
+	PageSize int `json:"page_size" example:"100"`
+	Total    int `json:"total"     example:"3000"`
+}
+
+// paginate returns the requested page of items along with the paging info
+// for the response
+func paginate[T any](
+	items []T,
+	req requestPagination,
+) ([]T, responsePagination) {
+	ret := responsePagination{
+		Page:     req.Page,
+		PageSize: req.PageSize,
+		Total:    len(items),
+	}
+	// Avoid overflow when calculating the offset for very large page numbers
+	if req.Page-1 > len(items)/req.PageSize {
+		return items[:0], ret
+	}
+	start := min((req.Page-1)*req.PageSize, len(items))
+	end := min(start+req.PageSize, len(items))
+	return items[start:end], ret
+}
+
+type responseHealthcheck struct {
+	Failed  bool   `json:"failed"`
+	Live    bool   `json:"live"`
+	Ready   bool   `json:"ready"`
+	TipSlot uint64 `json:"tip_slot,omitempty"`
+	TipTime string `json:"tip_time,omitempty"`
+	TipAge  int64  `json:"tip_age_seconds"`
+	Error   string `json:"error,omitempty"`
+}
+
+func handleHealthcheckLive(c *gin.Context) {
+	status := node.GetHealthChecker().Check(c.Request.Context())
+	healthcheckResponse(c, status, status.Live)
+}
+
+func handleHealthcheckReady(c *gin.Context) {
+	status := node.GetHealthChecker().Check(c.Request.Context())
+	healthcheckResponse(c, status, status.Ready)
+}
+
+func healthcheckResponse(c *gin.Context, status node.HealthStatus, ok bool) {
+	resp := responseHealthcheck{
+		Failed: !ok,
+		Live:   status.Live,
+		Ready:  status.Ready,
+		TipAge: int64(status.TipAge.Seconds()),
+		Error:  status.Error,
+	}
+	if !status.TipTime.IsZero() {
+		resp.TipSlot = status.TipSlot
+		resp.TipTime = status.TipTime.Format(time.RFC3339)
+	}
+	if !ok {
+		c.JSON(http.StatusServiceUnavailable, resp)
+		return
+	}
+	c.JSON(http.StatusOK, resp)
 }
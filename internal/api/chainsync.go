@@ -18,7 +18,6 @@ import (
 	"encoding/hex"
 	"net/http"
 
-	"github.com/blinklabs-io/adder/event"
 	"github.com/blinklabs-io/cardano-node-api/internal/node"
 	ocommon "github.com/blinklabs-io/gouroboros/protocol/common"
 	"github.com/gin-gonic/gin"
@@ -68,40 +67,8 @@ func handleChainSyncSync(c *gin.Context) {
 		)
 		return
 	}
-	// Setup event channel
-	eventChan := make(chan event.Event, 10)
-	connCfg := node.ConnectionConfig{
-		ChainSyncEventChan: eventChan,
-	}
-	// Connect to node
-	oConn, err := node.GetConnection(&connCfg)
-	if err != nil {
-		c.JSON(500, apiError(err.Error()))
-		return
-	}
-	// Async error handler
-	go func() {
-		err, ok := <-oConn.ErrorChan()
-		if !ok {
-			return
-		}
-		c.JSON(500, apiError(err.Error()))
-	}()
-	defer func() {
-		// Close Ouroboros connection
-		oConn.Close()
-	}()
 	var intersectPoints []ocommon.Point
-	if req.Tip {
-		tip, err := oConn.ChainSync().Client.GetCurrentTip()
-		if err != nil {
-			c.JSON(500, apiError(err.Error()))
-			return
-		}
-		intersectPoints = []ocommon.Point{
-			tip.Point,
-		}
-	} else {
+	if !req.Tip {
 		hashBytes, err := hex.DecodeString(req.Hash)
 		if err != nil {
 			c.JSON(500, apiError(err.Error()))
@@ -111,11 +78,14 @@ func handleChainSyncSync(c *gin.Context) {
 			ocommon.NewPoint(req.Slot, hashBytes),
 		}
 	}
-	// Start the sync with the node
-	if err := oConn.ChainSync().Client.Sync(intersectPoints); err != nil {
+	// Start the sync with the node. The follower reconnects automatically if
+	// the node connection drops and emits a resync event when it resumes.
+	follower := node.NewChainFollower(intersectPoints)
+	if err := follower.Start(c.Request.Context()); err != nil {
 		c.JSON(500, apiError(err.Error()))
 		return
 	}
+	defer follower.Stop()
 	// Upgrade the connection
 	webConn, err := upgrader.Upgrade(c.Writer, c.Request, nil)
 	if err != nil {
@@ -124,12 +94,11 @@ func handleChainSyncSync(c *gin.Context) {
 	defer webConn.Close()
 	// Wait for events
 	for {
-		evt, ok := <-eventChan
+		evt, ok := <-follower.EventChan()
 		if !ok {
 			return
 		}
 		if err := webConn.WriteJSON(evt); err != nil {
-			c.JSON(500, apiError(err.Error()))
 			return
 		}
 	}
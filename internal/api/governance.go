@@ -0,0 +1,642 @@
+// Copyright 2025 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/blinklabs-io/cardano-node-api/internal/node"
+	"github.com/blinklabs-io/gouroboros/cbor"
+	"github.com/blinklabs-io/gouroboros/ledger"
+	lcommon "github.com/blinklabs-io/gouroboros/ledger/common"
+	"github.com/blinklabs-io/gouroboros/ledger/conway"
+	"github.com/blinklabs-io/gouroboros/protocol/localstatequery"
+	"github.com/gin-gonic/gin"
+)
+
+func configureGovernanceRoutes(group *gin.RouterGroup) {
+	govGroup := group.Group("/governance")
+	govGroup.GET("/constitution", handleLocalStateQueryConstitution)
+	govGroup.GET("/proposals", handleLocalStateQueryProposals)
+	govGroup.GET("/proposals/:tx_hash/:index", handleLocalStateQueryProposal)
+	govGroup.GET("/dreps", handleLocalStateQueryDreps)
+	govGroup.GET("/committee", handleLocalStateQueryCommittee)
+}
+
+type govAnchor struct {
+	Url      string `json:"url"       example:"https://example.com/anchor.json"`
+	DataHash string `json:"data_hash"`
+}
+
+func newGovAnchor(anchor lcommon.GovAnchor) govAnchor {
+	return govAnchor{
+		Url:      anchor.Url,
+		DataHash: hex.EncodeToString(anchor.DataHash[:]),
+	}
+}
+
+func newGovAnchorPtr(anchor *lcommon.GovAnchor) *govAnchor {
+	if anchor == nil {
+		return nil
+	}
+	ret := newGovAnchor(*anchor)
+	return &ret
+}
+
+type responseLocalStateQueryConstitution struct {
+	Anchor               govAnchor `json:"anchor"`
+	GuardrailsScriptHash string    `json:"guardrails_script_hash,omitempty"`
+}
+
+// handleLocalStateQueryConstitution godoc
+//
+//	@Summary		Query Constitution
+//	@Description	Get the current constitution anchor and guardrails script hash. Requires the Conway era or later.
+//	@Tags			localstatequery
+//	@Produce		json
+//	@Success		200	{object}	responseLocalStateQueryConstitution
+//	@Failure		500	{object}	responseApiError
+//	@Router			/localstatequery/governance/constitution [get]
+func handleLocalStateQueryConstitution(c *gin.Context) {
+	// Lease a node connection from the pool
+	lease, err := node.AcquireConnection(c.Request.Context())
+	if err != nil {
+		c.JSON(500, apiError(err.Error()))
+		return
+	}
+	defer lease.Release()
+
+	// Get constitution
+	constitution, err := lease.LocalStateQuery().GetConstitution()
+	if err != nil {
+		c.JSON(500, apiError(err.Error()))
+		return
+	}
+
+	// Create response
+	resp := responseLocalStateQueryConstitution{
+		Anchor:               newGovAnchor(constitution.Anchor),
+		GuardrailsScriptHash: hex.EncodeToString(constitution.ScriptHash),
+	}
+	c.JSON(200, resp)
+}
+
+var govActionTypeNames = map[lcommon.GovActionType]string{
+	lcommon.GovActionTypeParameterChange:    "parameter_change",
+	lcommon.GovActionTypeHardForkInitiation: "hard_fork_initiation",
+	lcommon.GovActionTypeTreasuryWithdrawal: "treasury_withdrawals",
+	lcommon.GovActionTypeNoConfidence:       "no_confidence",
+	lcommon.GovActionTypeUpdateCommittee:    "update_committee",
+	lcommon.GovActionTypeNewConstitution:    "new_constitution",
+	lcommon.GovActionTypeInfo:               "info",
+}
+
+var voteNames = map[lcommon.Vote]string{
+	lcommon.Vote(lcommon.GovVoteNo):      "no",
+	lcommon.Vote(lcommon.GovVoteYes):     "yes",
+	lcommon.Vote(lcommon.GovVoteAbstain): "abstain",
+}
+
+type voteTally struct {
+	Yes     int `json:"yes"`
+	No      int `json:"no"`
+	Abstain int `json:"abstain"`
+}
+
+func (t *voteTally) add(vote lcommon.Vote) {
+	switch vote {
+	case lcommon.Vote(lcommon.GovVoteYes):
+		t.Yes++
+	case lcommon.Vote(lcommon.GovVoteNo):
+		t.No++
+	case lcommon.Vote(lcommon.GovVoteAbstain):
+		t.Abstain++
+	}
+}
+
+type govVoteTallies struct {
+	Committee voteTally `json:"committee"`
+	Drep      voteTally `json:"drep"`
+	Spo       voteTally `json:"spo"`
+}
+
+type govVote struct {
+	VoterRole string `json:"voter_role" example:"drep"  enums:"committee,drep,spo"`
+	// Voter is the CIP-0129 bech32 ID of the voting committee hot credential
+	// or DRep, or the bech32 ID of the voting pool
+	Voter string `json:"voter"`
+	Vote  string `json:"vote"       example:"yes" enums:"yes,no,abstain"`
+}
+
+type govProposalItem struct {
+	Id            string         `json:"id"                       example:"9f6a2cd3c2a25e1f4a6ec0f8c8ec1e30d4a5e5d0b6a56cb4df0b7a6b2f23e8d1#0"`
+	Type          string         `json:"type"                     example:"info"                                                               enums:"parameter_change,hard_fork_initiation,treasury_withdrawals,no_confidence,update_committee,new_constitution,info"`
+	PrevActionId  string         `json:"prev_action_id,omitempty"`
+	Deposit       uint64         `json:"deposit"                  example:"100000000000"                                                       format:"int64"                                                                                                          minimum:"0"`
+	ReturnAddress string         `json:"return_address"`
+	Anchor        govAnchor      `json:"anchor"`
+	ProposedIn    uint64         `json:"proposed_in"              example:"500"`
+	ExpiresAfter  uint64         `json:"expires_after"            example:"506"`
+	Tallies       govVoteTallies `json:"tallies"`
+	// Votes is only populated when querying a single proposal
+	Votes []govVote `json:"votes,omitempty"`
+}
+
+func newGovProposalItem(
+	state localstatequery.GovActionState,
+	includeVotes bool,
+) (govProposalItem, error) {
+	var proposal conway.ConwayProposalProcedure
+	if _, err := cbor.Decode(state.ProposalProcedure, &proposal); err != nil {
+		return govProposalItem{}, fmt.Errorf(
+			"failed to decode proposal procedure for %s: %w",
+			node.FormatGovActionId(state.Id),
+			err,
+		)
+	}
+	govActionType := lcommon.GovActionType(proposal.PPGovAction.Type)
+	ret := govProposalItem{
+		Id:            node.FormatGovActionId(state.Id),
+		Type:          govActionTypeNames[govActionType],
+		Deposit:       proposal.PPDeposit,
+		ReturnAddress: proposal.PPRewardAccount.String(),
+		Anchor:        newGovAnchor(proposal.PPAnchor),
+		ProposedIn:    state.ProposedIn,
+		ExpiresAfter:  state.ExpiresAfter,
+	}
+	prevActionId := govActionPrevId(proposal.PPGovAction.Action)
+	if prevActionId != nil {
+		ret.PrevActionId = node.FormatGovActionId(*prevActionId)
+	}
+	// Committee votes are cast by hot credentials
+	for cred, vote := range state.CommitteeVotes {
+		ret.Tallies.Committee.add(vote)
+		if includeVotes {
+			voter, err := node.EncodeCip129Credential(
+				"cc_hot",
+				node.Cip129KeyTypeCommitteeHot,
+				cred.Tag,
+				cred.Bytes.Bytes(),
+			)
+			if err != nil {
+				return govProposalItem{}, err
+			}
+			ret.Votes = append(
+				ret.Votes,
+				govVote{
+					VoterRole: "committee",
+					Voter:     voter,
+					Vote:      voteNames[vote],
+				},
+			)
+		}
+	}
+	for cred, vote := range state.DRepVotes {
+		ret.Tallies.Drep.add(vote)
+		if includeVotes {
+			voter, err := node.EncodeCip129Credential(
+				"drep",
+				node.Cip129KeyTypeDrep,
+				cred.Tag,
+				cred.Bytes.Bytes(),
+			)
+			if err != nil {
+				return govProposalItem{}, err
+			}
+			ret.Votes = append(
+				ret.Votes,
+				govVote{VoterRole: "drep", Voter: voter, Vote: voteNames[vote]},
+			)
+		}
+	}
+	for poolId, vote := range state.SPOVotes {
+		ret.Tallies.Spo.add(vote)
+		if includeVotes {
+			ret.Votes = append(
+				ret.Votes,
+				govVote{
+					VoterRole: "spo",
+					Voter:     ledger.PoolId(poolId).String(),
+					Vote:      voteNames[vote],
+				},
+			)
+		}
+	}
+	// Give the votes a stable order
+	slices.SortFunc(ret.Votes, func(a, b govVote) int {
+		if c := strings.Compare(a.VoterRole, b.VoterRole); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Voter, b.Voter)
+	})
+	return ret, nil
+}
+
+// govActionPrevId returns the ID of the previous governance action of the same
+// purpose that the provided action builds on, if any
+func govActionPrevId(action lcommon.GovAction) *lcommon.GovActionId {
+	switch a := action.(type) {
+	case *conway.ConwayParameterChangeGovAction:
+		return a.ActionId
+	case *lcommon.HardForkInitiationGovAction:
+		return a.ActionId
+	case *lcommon.NoConfidenceGovAction:
+		return a.ActionId
+	case *lcommon.UpdateCommitteeGovAction:
+		return a.ActionId
+	case *lcommon.NewConstitutionGovAction:
+		return a.ActionId
+	default:
+		return nil
+	}
+}
+
+type responseLocalStateQueryProposals struct {
+	responsePagination
+	Proposals []govProposalItem `json:"proposals"`
+}
+
+// handleLocalStateQueryProposals godoc
+//
+//	@Summary		Query Governance Proposals
+//	@Description	Get the active governance proposals and their vote tallies, ordered by action ID. Requires the Conway era or later.
+//	@Tags			localstatequery
+//	@Produce		json
+//	@Param			page		query		int	false	"Page number"	default(1)		minimum(1)
+//	@Param			page_size	query		int	false	"Page size"		default(100)	minimum(1)	maximum(1000)
+//	@Success		200			{object}	responseLocalStateQueryProposals
+//	@Failure		400			{object}	responseApiError
+//	@Failure		500			{object}	responseApiError
+//	@Router			/localstatequery/governance/proposals [get]
+func handleLocalStateQueryProposals(c *gin.Context) {
+	var req requestPagination
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(http.StatusBadRequest, apiError(err.Error()))
+		return
+	}
+
+	// Lease a node connection from the pool
+	lease, err := node.AcquireConnection(c.Request.Context())
+	if err != nil {
+		c.JSON(500, apiError(err.Error()))
+		return
+	}
+	defer lease.Release()
+
+	// Get proposals
+	proposals, err := lease.LocalStateQuery().GetProposals()
+	if err != nil {
+		c.JSON(500, apiError(err.Error()))
+		return
+	}
+
+	// Create response
+	tmpProposals := slices.Clone(*proposals)
+	slices.SortFunc(tmpProposals, compareGovActionStates)
+	pageProposals, paging := paginate(tmpProposals, req)
+	resp := responseLocalStateQueryProposals{
+		responsePagination: paging,
+		Proposals:          make([]govProposalItem, 0, len(pageProposals)),
+	}
+	for _, proposal := range pageProposals {
+		tmpItem, err := newGovProposalItem(proposal, false)
+		if err != nil {
+			c.JSON(500, apiError(err.Error()))
+			return
+		}
+		resp.Proposals = append(resp.Proposals, tmpItem)
+	}
+	c.JSON(200, resp)
+}
+
+func compareGovActionStates(a, b localstatequery.GovActionState) int {
+	if c := slices.Compare(
+		a.Id.TransactionId[:],
+		b.Id.TransactionId[:],
+	); c != 0 {
+		return c
+	}
+	return int(a.Id.GovActionIdx) - int(b.Id.GovActionIdx)
+}
+
+type requestLocalStateQueryProposal struct {
+	TxHash string `uri:"tx_hash" binding:"required"`
+	Index  string `uri:"index"   binding:"required"`
+}
+
+// handleLocalStateQueryProposal godoc
+//
+//	@Summary		Query Governance Proposal
+//	@Description	Get an active governance proposal along with the individual votes cast on it. Requires the Conway era or later.
+//	@Tags			localstatequery
+//	@Produce		json
+//	@Param			tx_hash	path		string	true	"Transaction hash of the proposal"
+//	@Param			index	path		int		true	"Index of the proposal within the transaction"
+//	@Success		200		{object}	govProposalItem
+//	@Failure		400		{object}	responseApiError
+//	@Failure		404		{object}	responseApiError
+//	@Failure		500		{object}	responseApiError
+//	@Router			/localstatequery/governance/proposals/{tx_hash}/{index} [get]
+func handleLocalStateQueryProposal(c *gin.Context) {
+	var req requestLocalStateQueryProposal
+	if err := c.ShouldBindUri(&req); err != nil {
+		c.JSON(http.StatusBadRequest, apiError(err.Error()))
+		return
+	}
+	govActionId, err := node.ParseGovActionId(req.TxHash + "#" + req.Index)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, apiError(err.Error()))
+		return
+	}
+
+	// Lease a node connection from the pool
+	lease, err := node.AcquireConnection(c.Request.Context())
+	if err != nil {
+		c.JSON(500, apiError(err.Error()))
+		return
+	}
+	defer lease.Release()
+
+	// Get proposals
+	proposals, err := lease.LocalStateQuery().GetProposals()
+	if err != nil {
+		c.JSON(500, apiError(err.Error()))
+		return
+	}
+
+	// Create response
+	for _, proposal := range *proposals {
+		if !proposal.Id.Equal(govActionId) {
+			continue
+		}
+		resp, err := newGovProposalItem(proposal, true)
+		if err != nil {
+			c.JSON(500, apiError(err.Error()))
+			return
+		}
+		c.JSON(200, resp)
+		return
+	}
+	c.JSON(http.StatusNotFound, apiError("proposal not found"))
+}
+
+type drepItem struct {
+	// DrepId is the CIP-0129 bech32 ID of the DRep
+	DrepId      string     `json:"drep_id"`
+	ExpiryEpoch uint64     `json:"expiry_epoch" example:"520"`
+	Deposit     uint64     `json:"deposit"      example:"500000000"     format:"int64" minimum:"0"`
+	Anchor      *govAnchor `json:"anchor,omitempty"`
+	Delegators  int        `json:"delegators"   example:"42"`
+	Stake       uint64     `json:"stake"        example:"1000000000000" format:"int64" minimum:"0"`
+}
+
+type responseLocalStateQueryDreps struct {
+	responsePagination
+	Dreps []drepItem `json:"dreps"`
+	// AbstainStake and NoConfidenceStake are the stake delegated to the
+	// predefined always-abstain and always-no-confidence options
+	AbstainStake      uint64 `json:"abstain_stake"       format:"int64" minimum:"0"`
+	NoConfidenceStake uint64 `json:"no_confidence_stake" format:"int64" minimum:"0"`
+}
+
+func newDrepsResponse(
+	drepState localstatequery.DRepStateResult,
+	drepStake []node.DrepStake,
+	req requestPagination,
+) (responseLocalStateQueryDreps, error) {
+	var resp responseLocalStateQueryDreps
+	stakeByDrep := make(map[string]uint64, len(drepStake))
+	for _, tmpStake := range drepStake {
+		switch tmpStake.Drep.Type {
+		case lcommon.DrepTypeAbstain:
+			resp.AbstainStake = tmpStake.Stake
+		case lcommon.DrepTypeNoConfidence:
+			resp.NoConfidenceStake = tmpStake.Stake
+		default:
+			stakeByDrep[tmpStake.Drep.String()] = tmpStake.Stake
+		}
+	}
+	dreps := make([]drepItem, 0, len(drepState))
+	for cred, entry := range drepState {
+		drepId, err := node.EncodeCip129Credential(
+			"drep",
+			node.Cip129KeyTypeDrep,
+			cred.Tag,
+			cred.Bytes.Bytes(),
+		)
+		if err != nil {
+			return resp, err
+		}
+		dreps = append(
+			dreps,
+			drepItem{
+				DrepId:      drepId,
+				ExpiryEpoch: entry.Expiry,
+				Deposit:     entry.Deposit,
+				Anchor:      newGovAnchorPtr(entry.Anchor),
+				Delegators:  len(entry.Delegators),
+				Stake:       stakeByDrep[drepId],
+			},
+		)
+	}
+	slices.SortFunc(dreps, func(a, b drepItem) int {
+		return strings.Compare(a.DrepId, b.DrepId)
+	})
+	resp.Dreps, resp.responsePagination = paginate(dreps, req)
+	return resp, nil
+}
+
+// handleLocalStateQueryDreps godoc
+//
+//	@Summary		Query DReps
+//	@Description	Get the registered DReps and the voting stake delegated to them, ordered by DRep ID. Requires the Conway era or later.
+//	@Tags			localstatequery
+//	@Produce		json
+//	@Param			page		query		int	false	"Page number"	default(1)		minimum(1)
+//	@Param			page_size	query		int	false	"Page size"		default(100)	minimum(1)	maximum(1000)
+//	@Success		200			{object}	responseLocalStateQueryDreps
+//	@Failure		400			{object}	responseApiError
+//	@Failure		500			{object}	responseApiError
+//	@Router			/localstatequery/governance/dreps [get]
+func handleLocalStateQueryDreps(c *gin.Context) {
+	var req requestPagination
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(http.StatusBadRequest, apiError(err.Error()))
+		return
+	}
+
+	// Lease a node connection from the pool
+	lease, err := node.AcquireConnection(c.Request.Context())
+	if err != nil {
+		c.JSON(500, apiError(err.Error()))
+		return
+	}
+	defer lease.Release()
+
+	// Get DRep state and stake
+	drepState, err := lease.LocalStateQuery().GetDRepState(nil)
+	if err != nil {
+		c.JSON(500, apiError(err.Error()))
+		return
+	}
+	drepStakeDistr, err := lease.LocalStateQuery().GetDRepStakeDistr(nil)
+	if err != nil {
+		c.JSON(500, apiError(err.Error()))
+		return
+	}
+	drepStake, err := node.DecodeDrepStakeDistr(*drepStakeDistr)
+	if err != nil {
+		c.JSON(500, apiError(err.Error()))
+		return
+	}
+
+	// Create response
+	resp, err := newDrepsResponse(*drepState, drepStake, req)
+	if err != nil {
+		c.JSON(500, apiError(err.Error()))
+		return
+	}
+	c.JSON(200, resp)
+}
+
+var hotCredStatusNames = map[localstatequery.HotCredAuthStatus]string{
+	localstatequery.HotCredNotAuthorized: "not_authorized",
+	localstatequery.HotCredAuthorized:    "authorized",
+	localstatequery.HotCredResigned:      "resigned",
+}
+
+var memberStatusNames = map[localstatequery.MemberStatus]string{
+	localstatequery.MemberStatusActive:       "active",
+	localstatequery.MemberStatusExpired:      "expired",
+	localstatequery.MemberStatusUnrecognized: "unrecognized",
+}
+
+var nextEpochChangeNames = map[localstatequery.NextEpochChange]string{
+	localstatequery.NextEpochNoChange:     "no_change",
+	localstatequery.NextEpochToBeEnacted:  "to_be_enacted",
+	localstatequery.NextEpochToBeRemoved:  "to_be_removed",
+	localstatequery.NextEpochToBeExpired:  "to_be_expired",
+	localstatequery.NextEpochTermAdjusted: "term_adjusted",
+}
+
+type committeeMemberItem struct {
+	// ColdCredential is the CIP-0129 bech32 ID of the member's cold credential
+	ColdCredential string `json:"cold_credential"`
+	// HotCredential is the CIP-0129 bech32 ID of the authorized hot
+	// credential, if any
+	HotCredential       string     `json:"hot_credential,omitempty"`
+	HotCredentialStatus string     `json:"hot_credential_status"           example:"authorized"   enums:"not_authorized,authorized,resigned"`
+	ResignationAnchor   *govAnchor `json:"resignation_anchor,omitempty"`
+	Status              string     `json:"status"                          example:"active"       enums:"active,expired,unrecognized"`
+	ExpiryEpoch         *uint64    `json:"expiry_epoch,omitempty"          example:"580"`
+	NextEpochChange     string     `json:"next_epoch_change"               example:"no_change"    enums:"no_change,to_be_enacted,to_be_removed,to_be_expired,term_adjusted"`
+	AdjustedExpiryEpoch *uint64    `json:"adjusted_expiry_epoch,omitempty" example:"600"`
+}
+
+type responseLocalStateQueryCommittee struct {
+	Threshold *rational             `json:"threshold,omitempty"`
+	Epoch     uint64                `json:"epoch"               example:"510"`
+	Members   []committeeMemberItem `json:"members"`
+}
+
+func newCommitteeResponse(
+	committee *localstatequery.CommitteeMembersStateResult,
+) (responseLocalStateQueryCommittee, error) {
+	resp := responseLocalStateQueryCommittee{
+		Threshold: newRationalPtr(committee.Threshold),
+		Epoch:     committee.Epoch,
+		Members:   make([]committeeMemberItem, 0, len(committee.Members)),
+	}
+	for cred, member := range committee.Members {
+		coldCred, err := node.EncodeCip129Credential(
+			"cc_cold",
+			node.Cip129KeyTypeCommitteeCold,
+			cred.Tag,
+			cred.Bytes.Bytes(),
+		)
+		if err != nil {
+			return resp, err
+		}
+		hotCredStatus := member.HotCredStatus
+		nextEpochChange := member.NextEpochChange
+		tmpItem := committeeMemberItem{
+			ColdCredential:      coldCred,
+			HotCredentialStatus: hotCredStatusNames[hotCredStatus.Status],
+			ResignationAnchor:   newGovAnchorPtr(hotCredStatus.Anchor),
+			Status:              memberStatusNames[member.Status],
+			ExpiryEpoch:         member.Expiry,
+			NextEpochChange:     nextEpochChangeNames[nextEpochChange.Change],
+			AdjustedExpiryEpoch: nextEpochChange.AdjustedEpoch,
+		}
+		if hotCred := hotCredStatus.Credential; hotCred != nil {
+			tmpItem.HotCredential, err = node.EncodeCip129Credential(
+				"cc_hot",
+				node.Cip129KeyTypeCommitteeHot,
+				uint64(hotCred.CredType),
+				hotCred.Credential.Bytes(),
+			)
+			if err != nil {
+				return resp, err
+			}
+		}
+		resp.Members = append(resp.Members, tmpItem)
+	}
+	slices.SortFunc(resp.Members, func(a, b committeeMemberItem) int {
+		return strings.Compare(a.ColdCredential, b.ColdCredential)
+	})
+	return resp, nil
+}
+
+// handleLocalStateQueryCommittee godoc
+//
+//	@Summary		Query Constitutional Committee
+//	@Description	Get the constitutional committee members and their hot credential authorizations, along with the committee voting threshold. Requires the Conway era or later.
+//	@Tags			localstatequery
+//	@Produce		json
+//	@Success		200	{object}	responseLocalStateQueryCommittee
+//	@Failure		500	{object}	responseApiError
+//	@Router			/localstatequery/governance/committee [get]
+func handleLocalStateQueryCommittee(c *gin.Context) {
+	// Lease a node connection from the pool
+	lease, err := node.AcquireConnection(c.Request.Context())
+	if err != nil {
+		c.JSON(500, apiError(err.Error()))
+		return
+	}
+	defer lease.Release()
+
+	// Get committee members state
+	committee, err := lease.LocalStateQuery().GetCommitteeMembersState(
+		nil,
+		nil,
+		nil,
+	)
+	if err != nil {
+		c.JSON(500, apiError(err.Error()))
+		return
+	}
+
+	// Create response
+	resp, err := newCommitteeResponse(committee)
+	if err != nil {
+		c.JSON(500, apiError(err.Error()))
+		return
+	}
+	c.JSON(200, resp)
+}
@@ -0,0 +1,306 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/blinklabs-io/cardano-node-api/internal/node"
+	"github.com/blinklabs-io/gouroboros/cbor"
+	"github.com/blinklabs-io/gouroboros/ledger"
+	lcommon "github.com/blinklabs-io/gouroboros/ledger/common"
+	"github.com/blinklabs-io/gouroboros/protocol/localstatequery"
+	"github.com/gin-gonic/gin"
+)
+
+func TestNewGovProposalItem(t *testing.T) {
+	rewardAccount, err := ledger.NewAddressFromParts(
+		lcommon.AddressTypeNoneKey,
+		lcommon.AddressNetworkMainnet,
+		nil,
+		make([]byte, lcommon.AddressHashSize),
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	prevActionId := lcommon.GovActionId{
+		TransactionId: [32]byte{0x02},
+		GovActionIdx:  1,
+	}
+	// No confidence action building on a previous action
+	proposalCbor, err := cbor.Encode(
+		[]any{
+			uint64(100000000000),
+			rewardAccount,
+			[]any{lcommon.GovActionTypeNoConfidence, prevActionId},
+			lcommon.GovAnchor{Url: "https://example.com/anchor.json"},
+		},
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	committeeCred := localstatequery.StakeCredential{
+		Tag:   lcommon.CredentialTypeAddrKeyHash,
+		Bytes: ledger.Blake2b224{0x03},
+	}
+	drepCred := localstatequery.StakeCredential{
+		Tag:   lcommon.CredentialTypeScriptHash,
+		Bytes: ledger.Blake2b224{0x04},
+	}
+	poolId := ledger.Blake2b224{0x05}
+	state := localstatequery.GovActionState{
+		Id: lcommon.GovActionId{
+			TransactionId: [32]byte{0x01},
+			GovActionIdx:  300,
+		},
+		CommitteeVotes: map[localstatequery.StakeCredential]lcommon.Vote{
+			committeeCred: lcommon.Vote(lcommon.GovVoteYes),
+		},
+		DRepVotes: map[localstatequery.StakeCredential]lcommon.Vote{
+			drepCred: lcommon.Vote(lcommon.GovVoteAbstain),
+		},
+		SPOVotes: map[ledger.Blake2b224]lcommon.Vote{
+			poolId: lcommon.Vote(lcommon.GovVoteNo),
+		},
+		ProposalProcedure: proposalCbor,
+		ProposedIn:        500,
+		ExpiresAfter:      506,
+	}
+
+	item, err := newGovProposalItem(state, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if item.Id != node.FormatGovActionId(state.Id) ||
+		!strings.HasSuffix(item.Id, "#300") {
+		t.Fatalf("unexpected ID: %s", item.Id)
+	}
+	if item.Type != "no_confidence" {
+		t.Fatalf("unexpected type: %s", item.Type)
+	}
+	if item.PrevActionId != node.FormatGovActionId(prevActionId) {
+		t.Fatalf("unexpected previous action ID: %s", item.PrevActionId)
+	}
+	if item.Deposit != 100000000000 ||
+		item.ReturnAddress != rewardAccount.String() ||
+		item.Anchor.Url != "https://example.com/anchor.json" {
+		t.Fatalf("unexpected proposal procedure: %+v", item)
+	}
+	if item.Tallies.Committee.Yes != 1 || item.Tallies.Drep.Abstain != 1 ||
+		item.Tallies.Spo.No != 1 {
+		t.Fatalf("unexpected tallies: %+v", item.Tallies)
+	}
+	if item.Votes != nil {
+		t.Fatalf("unexpected votes: %+v", item.Votes)
+	}
+
+	item, err = newGovProposalItem(state, true)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	expectedVotes := []struct {
+		role   string
+		prefix string
+		vote   string
+	}{
+		{role: "committee", prefix: "cc_hot1", vote: "yes"},
+		{role: "drep", prefix: "drep1", vote: "abstain"},
+		{role: "spo", prefix: "pool1", vote: "no"},
+	}
+	if len(item.Votes) != len(expectedVotes) {
+		t.Fatalf("unexpected votes: %+v", item.Votes)
+	}
+	for idx, expected := range expectedVotes {
+		vote := item.Votes[idx]
+		if vote.VoterRole != expected.role ||
+			!strings.HasPrefix(vote.Voter, expected.prefix) ||
+			vote.Vote != expected.vote {
+			t.Errorf("unexpected vote %d: %+v", idx, vote)
+		}
+	}
+}
+
+func TestNewDrepsResponse(t *testing.T) {
+	keyCred := localstatequery.StakeCredential{
+		Tag:   lcommon.CredentialTypeAddrKeyHash,
+		Bytes: ledger.Blake2b224{0x01},
+	}
+	scriptCred := localstatequery.StakeCredential{
+		Tag:   lcommon.CredentialTypeScriptHash,
+		Bytes: ledger.Blake2b224{0x02},
+	}
+	drepState := localstatequery.DRepStateResult{
+		keyCred: {
+			Expiry:     520,
+			Deposit:    500000000,
+			Anchor:     &lcommon.GovAnchor{Url: "https://example.com"},
+			Delegators: []localstatequery.StakeCredential{scriptCred},
+		},
+		scriptCred: {
+			Expiry:  530,
+			Deposit: 500000000,
+		},
+	}
+	drepStake := []node.DrepStake{
+		{
+			Drep: lcommon.Drep{
+				Type:       lcommon.DrepTypeAddrKeyHash,
+				Credential: keyCred.Bytes.Bytes(),
+			},
+			Stake: 1000,
+		},
+		{Drep: lcommon.Drep{Type: lcommon.DrepTypeAbstain}, Stake: 20},
+		{Drep: lcommon.Drep{Type: lcommon.DrepTypeNoConfidence}, Stake: 30},
+	}
+
+	resp, err := newDrepsResponse(
+		drepState,
+		drepStake,
+		requestPagination{Page: 1, PageSize: 100},
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if resp.Total != 2 || len(resp.Dreps) != 2 {
+		t.Fatalf("unexpected DReps: %+v", resp)
+	}
+	if resp.AbstainStake != 20 || resp.NoConfidenceStake != 30 {
+		t.Fatalf("unexpected predefined DRep stake: %+v", resp)
+	}
+	keyDrep := lcommon.Drep{
+		Type:       lcommon.DrepTypeAddrKeyHash,
+		Credential: keyCred.Bytes.Bytes(),
+	}
+	var found bool
+	for idx, tmpItem := range resp.Dreps {
+		if idx > 0 && resp.Dreps[idx-1].DrepId >= tmpItem.DrepId {
+			t.Fatalf("DReps not sorted: %+v", resp.Dreps)
+		}
+		if tmpItem.DrepId != keyDrep.String() {
+			if tmpItem.Stake != 0 || tmpItem.Anchor != nil {
+				t.Errorf("unexpected DRep: %+v", tmpItem)
+			}
+			continue
+		}
+		found = true
+		if tmpItem.Stake != 1000 || tmpItem.Delegators != 1 ||
+			tmpItem.ExpiryEpoch != 520 || tmpItem.Anchor == nil {
+			t.Errorf("unexpected DRep: %+v", tmpItem)
+		}
+	}
+	if !found {
+		t.Fatalf("DRep %s not found: %+v", keyDrep.String(), resp.Dreps)
+	}
+}
+
+func TestNewCommitteeResponse(t *testing.T) {
+	expiry := uint64(580)
+	adjustedEpoch := uint64(600)
+	hotCred := lcommon.Credential{
+		CredType:   lcommon.CredentialTypeAddrKeyHash,
+		Credential: lcommon.CredentialHash{0x03},
+	}
+	committee := &localstatequery.CommitteeMembersStateResult{
+		Members: map[localstatequery.StakeCredential]localstatequery.CommitteeMemberState{
+			{Tag: 0, Bytes: ledger.Blake2b224{0x01}}: {
+				HotCredStatus: localstatequery.HotCredAuthStatusValue{
+					Status:     localstatequery.HotCredAuthorized,
+					Credential: &hotCred,
+				},
+				Status: localstatequery.MemberStatusActive,
+				Expiry: &expiry,
+				NextEpochChange: localstatequery.NextEpochChangeValue{
+					Change:        localstatequery.NextEpochTermAdjusted,
+					AdjustedEpoch: &adjustedEpoch,
+				},
+			},
+			{Tag: 1, Bytes: ledger.Blake2b224{0x02}}: {
+				HotCredStatus: localstatequery.HotCredAuthStatusValue{
+					Status: localstatequery.HotCredResigned,
+				},
+				Status: localstatequery.MemberStatusExpired,
+			},
+		},
+		Threshold: &cbor.Rat{Rat: big.NewRat(2, 3)},
+		Epoch:     510,
+	}
+
+	resp, err := newCommitteeResponse(committee)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if resp.Epoch != 510 || resp.Threshold == nil ||
+		resp.Threshold.Numerator != 2 || resp.Threshold.Denominator != 3 {
+		t.Fatalf("unexpected committee state: %+v", resp)
+	}
+	if len(resp.Members) != 2 {
+		t.Fatalf("unexpected members: %+v", resp.Members)
+	}
+	for _, member := range resp.Members {
+		if !strings.HasPrefix(member.ColdCredential, "cc_cold1") {
+			t.Fatalf("unexpected cold credential: %s", member.ColdCredential)
+		}
+		switch member.Status {
+		case "active":
+			if !strings.HasPrefix(member.HotCredential, "cc_hot1") ||
+				member.HotCredentialStatus != "authorized" ||
+				member.ExpiryEpoch == nil || *member.ExpiryEpoch != expiry ||
+				member.NextEpochChange != "term_adjusted" ||
+				member.AdjustedExpiryEpoch == nil ||
+				*member.AdjustedExpiryEpoch != adjustedEpoch {
+				t.Errorf("unexpected member: %+v", member)
+			}
+		case "expired":
+			if member.HotCredential != "" ||
+				member.HotCredentialStatus != "resigned" ||
+				member.NextEpochChange != "no_change" {
+				t.Errorf("unexpected member: %+v", member)
+			}
+		default:
+			t.Errorf("unexpected member status: %s", member.Status)
+		}
+	}
+}
+
+func TestHandleLocalStateQueryGovernanceValidation(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	configureGovernanceRoutes(router.Group("/localstatequery"))
+
+	testDefs := []string{
+		"/localstatequery/governance/proposals?page=0",
+		"/localstatequery/governance/proposals?page_size=1001",
+		"/localstatequery/governance/proposals/deadbeef/0",
+		"/localstatequery/governance/proposals/9f6a2cd3c2a25e1f4a6ec0f8c8ec1e30d4a5e5d0b6a56cb4df0b7a6b2f23e8d1/abc",
+		"/localstatequery/governance/dreps?page=0",
+	}
+	for _, testDef := range testDefs {
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, testDef, nil)
+		router.ServeHTTP(w, req)
+		if w.Code != http.StatusBadRequest {
+			t.Fatalf(
+				"%s: expected status 400, got %d: %s",
+				testDef,
+				w.Code,
+				w.Body.String(),
+			)
+		}
+	}
+}
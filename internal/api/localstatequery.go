@@ -16,7 +16,10 @@ package api
 
 import (
 	"encoding/hex"
+	"errors"
+	"fmt"
 	"math"
+	"time"
 
 	"github.com/blinklabs-io/cardano-node-api/internal/config"
 	"github.com/blinklabs-io/cardano-node-api/internal/node"
@@ -31,11 +34,17 @@ func configureLocalStateQueryRoutes(apiGroup *gin.RouterGroup) {
 	group.GET("/system-start", handleLocalStateQuerySystemStart)
 	group.GET("/tip", handleLocalStateQueryTip)
 	group.GET("/era-history", handleLocalStateQueryEraHistory)
+	group.GET("/slot-to-time", handleLocalStateQuerySlotToTime)
+	group.GET("/time-to-slot", handleLocalStateQueryTimeToSlot)
+	group.GET("/slot-to-epoch", handleLocalStateQuerySlotToEpoch)
 	group.GET("/protocol-params", handleLocalStateQueryProtocolParams)
 	group.GET("/utxos/search-by-asset", handleLocalStateQuerySearchUTxOsByAsset)
-	// TODO: uncomment after this is fixed:
-	// - https://github.com/blinklabs-io/gouroboros/issues/584
-	// group.GET("/genesis-config", handleLocalStateQueryGenesisConfig)
+	group.GET("/utxos/by-address", handleLocalStateQueryUTxOsByAddress)
+	group.POST("/utxos/by-txin", handleLocalStateQueryUTxOsByTxIn)
+	group.GET("/genesis-config", handleLocalStateQueryGenesisConfig)
+	configurePoolRoutes(group)
+	configureAccountRoutes(group)
+	configureGovernanceRoutes(group)
 }
 
 type responseLocalStateQueryCurrentEra struct {
@@ -52,29 +61,16 @@ type responseLocalStateQueryCurrentEra struct {
 //	@Failure	500	{object}	responseApiError
 //	@Router		/localstatequery/current-era [get]
 func handleLocalStateQueryCurrentEra(c *gin.Context) {
-	// Connect to node
-	oConn, err := node.GetConnection(nil)
+	// Lease a node connection from the pool
+	lease, err := node.AcquireConnection(c.Request.Context())
 	if err != nil {
 		c.JSON(500, apiError(err.Error()))
 		return
 	}
-	// Async error handler
-	go func() {
-		err, ok := <-oConn.ErrorChan()
-		if !ok {
-			return
-		}
-		c.JSON(500, apiError(err.Error()))
-	}()
-	defer func() {
-		// Close Ouroboros connection
-		oConn.Close()
-	}()
-	// Start client
-	oConn.LocalStateQuery().Client.Start()
+	defer lease.Release()
 
 	// Get era
-	eraNum, err := oConn.LocalStateQuery().Client.GetCurrentEra()
+	eraNum, err := lease.LocalStateQuery().GetCurrentEra()
 	if err != nil {
 		c.JSON(500, apiError(err.Error()))
 		return
@@ -109,29 +105,16 @@ type responseLocalStateQuerySystemStart struct {
 //	@Failure	500	{object}	responseApiError
 //	@Router		/localstatequery/system-start [get]
 func handleLocalStateQuerySystemStart(c *gin.Context) {
-	// Connect to node
-	oConn, err := node.GetConnection(nil)
+	// Lease a node connection from the pool
+	lease, err := node.AcquireConnection(c.Request.Context())
 	if err != nil {
 		c.JSON(500, apiError(err.Error()))
 		return
 	}
-	// Async error handler
-	go func() {
-		err, ok := <-oConn.ErrorChan()
-		if !ok {
-			return
-		}
-		c.JSON(500, apiError(err.Error()))
-	}()
-	defer func() {
-		// Close Ouroboros connection
-		oConn.Close()
-	}()
-	// Start client
-	oConn.LocalStateQuery().Client.Start()
+	defer lease.Release()
 
 	// Get system start
-	result, err := oConn.LocalStateQuery().Client.GetSystemStart()
+	result, err := lease.LocalStateQuery().GetSystemStart()
 	if err != nil {
 		c.JSON(500, apiError(err.Error()))
 		return
@@ -167,29 +150,16 @@ type responseLocalStateQueryTip struct {
 //	@Failure	500	{object}	responseApiError
 //	@Router		/localstatequery/tip [get]
 func handleLocalStateQueryTip(c *gin.Context) {
-	// Connect to node
-	oConn, err := node.GetConnection(nil)
+	// Lease a node connection from the pool
+	lease, err := node.AcquireConnection(c.Request.Context())
 	if err != nil {
 		c.JSON(500, apiError(err.Error()))
 		return
 	}
-	// Async error handler
-	go func() {
-		err, ok := <-oConn.ErrorChan()
-		if !ok {
-			return
-		}
-		c.JSON(500, apiError(err.Error()))
-	}()
-	defer func() {
-		// Close Ouroboros connection
-		oConn.Close()
-	}()
-	// Start client
-	oConn.LocalStateQuery().Client.Start()
+	defer lease.Release()
 
 	// Get era
-	eraNum, err := oConn.LocalStateQuery().Client.GetCurrentEra()
+	eraNum, err := lease.LocalStateQuery().GetCurrentEra()
 	if err != nil {
 		c.JSON(500, apiError(err.Error()))
 		return
@@ -201,21 +171,21 @@ func handleLocalStateQueryTip(c *gin.Context) {
 	era := ledger.GetEraById(uint8(eraNum))
 
 	// Get epochNo
-	epochNo, err := oConn.LocalStateQuery().Client.GetEpochNo()
+	epochNo, err := lease.LocalStateQuery().GetEpochNo()
 	if err != nil {
 		c.JSON(500, apiError(err.Error()))
 		return
 	}
 
 	// Get blockNo
-	blockNo, err := oConn.LocalStateQuery().Client.GetChainBlockNo()
+	blockNo, err := lease.LocalStateQuery().GetChainBlockNo()
 	if err != nil {
 		c.JSON(500, apiError(err.Error()))
 		return
 	}
 
 	// Get chain point (slot and hash)
-	point, err := oConn.LocalStateQuery().Client.GetChainPoint()
+	point, err := lease.LocalStateQuery().GetChainPoint()
 	if err != nil {
 		c.JSON(500, apiError(err.Error()))
 		return
@@ -232,150 +202,431 @@ func handleLocalStateQueryTip(c *gin.Context) {
 	c.JSON(200, resp)
 }
 
-// TODO: fill this in
-//
-//nolint:unused
-type responseLocalStateQueryEraHistory struct{}
+type responseLocalStateQueryEraHistory struct {
+	SystemStart time.Time    `json:"system_start" example:"2017-09-23T21:44:51Z"`
+	Eras        []eraSummary `json:"eras"`
+}
+
+type eraSummary struct {
+	Era          string    `json:"era"            example:"Conway"`
+	Start        eraBound  `json:"start"`
+	End          *eraBound `json:"end,omitempty"`
+	EpochLength  uint64    `json:"epoch_length"   example:"432000"`
+	SlotLengthMs int64     `json:"slot_length_ms" example:"1000"`
+	SafeZone     uint64    `json:"safe_zone"      example:"129600"`
+}
+
+type eraBound struct {
+	Slot      uint64    `json:"slot"       example:"133660800"`
+	Epoch     uint64    `json:"epoch"      example:"507"`
+	Time      time.Time `json:"time"       example:"2024-09-01T21:44:51Z"`
+	POSIXTime int64     `json:"posix_time" example:"1725227091000"     format:"int64"`
+}
+
+func newEraBound(bound node.EraBound) eraBound {
+	return eraBound{
+		Slot:      bound.Slot,
+		Epoch:     bound.Epoch,
+		Time:      time.UnixMilli(bound.POSIXTime).UTC(),
+		POSIXTime: bound.POSIXTime,
+	}
+}
+
+func newEraHistoryResponse(
+	systemStartMs int64,
+	eraHistory []localstatequery.EraHistoryResult,
+) (responseLocalStateQueryEraHistory, error) {
+	eras, err := node.EraSummaries(systemStartMs, eraHistory)
+	if err != nil {
+		return responseLocalStateQueryEraHistory{}, err
+	}
+	resp := responseLocalStateQueryEraHistory{
+		SystemStart: time.UnixMilli(systemStartMs).UTC(),
+		Eras:        make([]eraSummary, 0, len(eras)),
+	}
+	for _, era := range eras {
+		tmpEra := eraSummary{
+			Era:          ledger.GetEraById(era.EraId).Name,
+			Start:        newEraBound(era.Begin),
+			EpochLength:  era.EpochLength,
+			SlotLengthMs: era.SlotLengthMs,
+			SafeZone:     era.SafeZone,
+		}
+		if era.End != nil {
+			end := newEraBound(*era.End)
+			tmpEra.End = &end
+		}
+		resp.Eras = append(resp.Eras, tmpEra)
+	}
+	return resp, nil
+}
 
 // handleLocalStateQueryEraHistory godoc
 //
-//	@Summary	Query Era History
-//	@Tags		localstatequery
-//	@Produce	json
-//	@Success	200	{object}	responseLocalStateQueryEraHistory
-//	@Failure	500	{object}	responseApiError
-//	@Router		/localstatequery/era-history [get]
+//	@Summary		Query Era History
+//	@Description	Query the era history. The end of the last era is the point up to which slot and time conversions are known to be stable.
+//	@Tags			localstatequery
+//	@Produce		json
+//	@Success		200	{object}	responseLocalStateQueryEraHistory
+//	@Failure		500	{object}	responseApiError
+//	@Router			/localstatequery/era-history [get]
 func handleLocalStateQueryEraHistory(c *gin.Context) {
-	// Connect to node
-	oConn, err := node.GetConnection(nil)
+	// Lease a node connection from the pool
+	lease, err := node.AcquireConnection(c.Request.Context())
 	if err != nil {
 		c.JSON(500, apiError(err.Error()))
 		return
 	}
-	// Async error handler
-	go func() {
-		err, ok := <-oConn.ErrorChan()
-		if !ok {
-			return
-		}
+	defer lease.Release()
+
+	// Get system start and era history
+	systemStartMs, eraHistory, err := node.QueryEraHistory(
+		lease.LocalStateQuery(),
+	)
+	if err != nil {
 		c.JSON(500, apiError(err.Error()))
-	}()
-	defer func() {
-		// Close Ouroboros connection
-		oConn.Close()
-	}()
-	// Start client
-	oConn.LocalStateQuery().Client.Start()
+		return
+	}
 
-	// Get eraHistory
-	eraHistory, err := oConn.LocalStateQuery().Client.GetEraHistory()
+	// Create response
+	resp, err := newEraHistoryResponse(systemStartMs, eraHistory)
+	if err != nil {
+		c.JSON(500, apiError(err.Error()))
+		return
+	}
+	c.JSON(200, resp)
+}
+
+type requestLocalStateQuerySlot struct {
+	Slot *uint64 `form:"slot" binding:"required"`
+}
+
+type responseLocalStateQuerySlotToTime struct {
+	Slot      uint64    `json:"slot"       example:"133660800"`
+	Time      time.Time `json:"time"       example:"2024-09-01T21:44:51Z"`
+	POSIXTime int64     `json:"posix_time" example:"1725227091000"     format:"int64"`
+}
+
+// handleLocalStateQuerySlotToTime godoc
+//
+//	@Summary		Convert Slot to Time
+//	@Description	Convert a slot number to the wall-clock time at the start of the slot using the era history
+//	@Tags			localstatequery
+//	@Produce		json
+//	@Param			slot	query		int	true	"slot number"
+//	@Success		200		{object}	responseLocalStateQuerySlotToTime
+//	@Failure		400		{object}	responseApiError
+//	@Failure		500		{object}	responseApiError
+//	@Router			/localstatequery/slot-to-time [get]
+func handleLocalStateQuerySlotToTime(c *gin.Context) {
+	// Get parameters
+	var req requestLocalStateQuerySlot
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(400, apiError(err.Error()))
+		return
+	}
+
+	// Lease a node connection from the pool
+	lease, err := node.AcquireConnection(c.Request.Context())
+	if err != nil {
+		c.JSON(500, apiError(err.Error()))
+		return
+	}
+	defer lease.Release()
+
+	// Get system start and era history
+	systemStartMs, eraHistory, err := node.QueryEraHistory(
+		lease.LocalStateQuery(),
+	)
+	if err != nil {
+		c.JSON(500, apiError(err.Error()))
+		return
+	}
+
+	// Convert slot
+	posixTime, err := node.SlotToPOSIXTime(
+		*req.Slot,
+		systemStartMs,
+		eraHistory,
+	)
+	if err != nil {
+		c.JSON(400, apiError(err.Error()))
+		return
+	}
+
+	// Create response
+	resp := responseLocalStateQuerySlotToTime{
+		Slot:      *req.Slot,
+		Time:      time.UnixMilli(posixTime).UTC(),
+		POSIXTime: posixTime,
+	}
+	c.JSON(200, resp)
+}
+
+type requestLocalStateQueryTimeToSlot struct {
+	POSIXTime *int64 `form:"posix_time" binding:"required"`
+}
+
+type responseLocalStateQueryTimeToSlot struct {
+	POSIXTime int64  `json:"posix_time" example:"1725227091500" format:"int64"`
+	Slot      uint64 `json:"slot"       example:"133660800"`
+	// SlotStart is the POSIX time at the start of the slot
+	SlotStart int64 `json:"slot_start" example:"1725227091000" format:"int64"`
+}
+
+// handleLocalStateQueryTimeToSlot godoc
+//
+//	@Summary		Convert Time to Slot
+//	@Description	Convert a POSIX time in milliseconds to the slot containing it using the era history
+//	@Tags			localstatequery
+//	@Produce		json
+//	@Param			posix_time	query		int	true	"POSIX time in milliseconds"
+//	@Success		200			{object}	responseLocalStateQueryTimeToSlot
+//	@Failure		400			{object}	responseApiError
+//	@Failure		500			{object}	responseApiError
+//	@Router			/localstatequery/time-to-slot [get]
+func handleLocalStateQueryTimeToSlot(c *gin.Context) {
+	// Get parameters
+	var req requestLocalStateQueryTimeToSlot
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(400, apiError(err.Error()))
+		return
+	}
+
+	// Lease a node connection from the pool
+	lease, err := node.AcquireConnection(c.Request.Context())
+	if err != nil {
+		c.JSON(500, apiError(err.Error()))
+		return
+	}
+	defer lease.Release()
+
+	// Get system start and era history
+	systemStartMs, eraHistory, err := node.QueryEraHistory(
+		lease.LocalStateQuery(),
+	)
+	if err != nil {
+		c.JSON(500, apiError(err.Error()))
+		return
+	}
+
+	// Convert time
+	slot, err := node.POSIXTimeToSlot(
+		*req.POSIXTime,
+		systemStartMs,
+		eraHistory,
+	)
+	if err != nil {
+		c.JSON(400, apiError(err.Error()))
+		return
+	}
+	slotStart, err := node.SlotToPOSIXTime(slot, systemStartMs, eraHistory)
 	if err != nil {
 		c.JSON(500, apiError(err.Error()))
 		return
 	}
 
 	// Create response
-	//resp := responseLocalStateQueryProtocolParams{
-	//}
-	c.JSON(200, eraHistory)
+	resp := responseLocalStateQueryTimeToSlot{
+		POSIXTime: *req.POSIXTime,
+		Slot:      slot,
+		SlotStart: slotStart,
+	}
+	c.JSON(200, resp)
 }
 
-// TODO: fill this in
+type responseLocalStateQuerySlotToEpoch struct {
+	Slot        uint64 `json:"slot"          example:"133660800"`
+	Epoch       uint64 `json:"epoch"         example:"507"`
+	SlotInEpoch uint64 `json:"slot_in_epoch" example:"0"`
+}
+
+// handleLocalStateQuerySlotToEpoch godoc
 //
-//nolint:unused
-type responseLocalStateQueryProtocolParams struct{}
+//	@Summary		Convert Slot to Epoch
+//	@Description	Get the epoch containing a slot and the slot's offset within that epoch using the era history
+//	@Tags			localstatequery
+//	@Produce		json
+//	@Param			slot	query		int	true	"slot number"
+//	@Success		200		{object}	responseLocalStateQuerySlotToEpoch
+//	@Failure		400		{object}	responseApiError
+//	@Failure		500		{object}	responseApiError
+//	@Router			/localstatequery/slot-to-epoch [get]
+func handleLocalStateQuerySlotToEpoch(c *gin.Context) {
+	// Get parameters
+	var req requestLocalStateQuerySlot
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(400, apiError(err.Error()))
+		return
+	}
+
+	// Lease a node connection from the pool
+	lease, err := node.AcquireConnection(c.Request.Context())
+	if err != nil {
+		c.JSON(500, apiError(err.Error()))
+		return
+	}
+	defer lease.Release()
+
+	// Get eraHistory
+	eraHistory, err := lease.LocalStateQuery().GetEraHistory()
+	if err != nil {
+		c.JSON(500, apiError(err.Error()))
+		return
+	}
+
+	// Convert slot
+	epoch, slotInEpoch, err := node.SlotToEpoch(*req.Slot, eraHistory)
+	if err != nil {
+		c.JSON(400, apiError(err.Error()))
+		return
+	}
+
+	// Create response
+	resp := responseLocalStateQuerySlotToEpoch{
+		Slot:        *req.Slot,
+		Epoch:       epoch,
+		SlotInEpoch: slotInEpoch,
+	}
+	c.JSON(200, resp)
+}
 
 // handleLocalStateQueryProtocolParams godoc
 //
-//	@Summary	Query Current Protocol Parameters
-//	@Tags		localstatequery
-//	@Produce	json
-//	@Success	200	{object}	responseLocalStateQueryProtocolParams
-//	@Failure	500	{object}	responseApiError
-//	@Router		/localstatequery/protocol-params [get]
+//	@Summary		Query Current Protocol Parameters
+//	@Description	Query the protocol parameters for the current era. Alonzo and later eras are supported, and fields which do not exist in the current era are omitted.
+//	@Tags			localstatequery
+//	@Produce		json
+//	@Success		200	{object}	responseLocalStateQueryProtocolParams
+//	@Failure		500	{object}	responseApiError
+//	@Router			/localstatequery/protocol-params [get]
 func handleLocalStateQueryProtocolParams(c *gin.Context) {
-	// Connect to node
-	oConn, err := node.GetConnection(nil)
+	// Lease a node connection from the pool
+	lease, err := node.AcquireConnection(c.Request.Context())
 	if err != nil {
 		c.JSON(500, apiError(err.Error()))
 		return
 	}
-	// Async error handler
-	go func() {
-		err, ok := <-oConn.ErrorChan()
-		if !ok {
-			return
-		}
-		c.JSON(500, apiError(err.Error()))
-	}()
-	defer func() {
-		// Close Ouroboros connection
-		oConn.Close()
-	}()
-	// Start client
-	oConn.LocalStateQuery().Client.Start()
+	defer lease.Release()
 
 	// Get protoParams
-	protoParams, err := oConn.LocalStateQuery().Client.GetCurrentProtocolParams()
+	protoParams, err := lease.LocalStateQuery().GetCurrentProtocolParams()
 	if err != nil {
 		c.JSON(500, apiError(err.Error()))
 		return
 	}
 
 	// Create response
-	//resp := responseLocalStateQueryProtocolParams{
-	//}
-	c.JSON(200, protoParams)
+	resp, err := newProtocolParamsResponse(protoParams)
+	if err != nil {
+		c.JSON(500, apiError(err.Error()))
+		return
+	}
+	c.JSON(200, resp)
 }
 
-// TODO: fill this in
-//
-//nolint:unused
-type responseLocalStateQueryGenesisConfig struct{}
+type responseLocalStateQueryGenesisConfig struct {
+	SystemStart       time.Time `json:"system_start"         example:"2017-09-23T21:44:51Z"`
+	NetworkMagic      uint32    `json:"network_magic"        example:"764824073"`
+	NetworkId         uint8     `json:"network_id"           example:"1"`
+	ActiveSlotsCoeff  rational  `json:"active_slots_coeff"`
+	SecurityParam     uint64    `json:"security_param"       example:"2160"`
+	EpochLength       uint64    `json:"epoch_length"         example:"432000"`
+	SlotsPerKESPeriod uint64    `json:"slots_per_kes_period" example:"129600"`
+	MaxKESEvolutions  uint64    `json:"max_kes_evolutions"   example:"62"`
+	SlotLengthMs      uint64    `json:"slot_length_ms"       example:"1000"`
+	UpdateQuorum      uint64    `json:"update_quorum"        example:"5"`
+	MaxLovelaceSupply uint64    `json:"max_lovelace_supply"  example:"45000000000000000" format:"int64" minimum:"0"`
+}
+
+func newGenesisConfigResponse(
+	genesisConfig *localstatequery.GenesisConfigResult,
+) (responseLocalStateQueryGenesisConfig, error) {
+	activeSlotsCoeff, err := newRationalFromArray(
+		genesisConfig.ActiveSlotsCoeff,
+	)
+	if err != nil {
+		return responseLocalStateQueryGenesisConfig{}, fmt.Errorf(
+			"decode active slots coefficient: %w",
+			err,
+		)
+	}
+	for _, val := range []int{
+		genesisConfig.SecurityParam,
+		genesisConfig.EpochLength,
+		genesisConfig.SlotsPerKESPeriod,
+		genesisConfig.MaxKESEvolutions,
+		genesisConfig.SlotLength,
+		genesisConfig.UpdateQuorum,
+	} {
+		if val < 0 {
+			return responseLocalStateQueryGenesisConfig{}, fmt.Errorf(
+				"genesis config contains negative value %d",
+				val,
+			)
+		}
+	}
+	if genesisConfig.NetworkMagic < 0 ||
+		genesisConfig.NetworkMagic > math.MaxUint32 {
+		return responseLocalStateQueryGenesisConfig{}, errors.New(
+			"network magic int overflow",
+		)
+	}
+	if genesisConfig.MaxLovelaceSupply < 0 {
+		return responseLocalStateQueryGenesisConfig{}, errors.New(
+			"genesis config contains negative max lovelace supply",
+		)
+	}
+	systemStartMs := node.SystemStartToUnixMs(&genesisConfig.Start)
+	resp := responseLocalStateQueryGenesisConfig{
+		SystemStart:       time.UnixMilli(systemStartMs).UTC(),
+		NetworkMagic:      uint32(genesisConfig.NetworkMagic),
+		NetworkId:         genesisConfig.NetworkId,
+		ActiveSlotsCoeff:  activeSlotsCoeff,
+		SecurityParam:     uint64(genesisConfig.SecurityParam),
+		EpochLength:       uint64(genesisConfig.EpochLength),
+		SlotsPerKESPeriod: uint64(genesisConfig.SlotsPerKESPeriod),
+		MaxKESEvolutions:  uint64(genesisConfig.MaxKESEvolutions),
+		// The slot length is encoded in microseconds
+		SlotLengthMs:      uint64(genesisConfig.SlotLength) / 1000,
+		UpdateQuorum:      uint64(genesisConfig.UpdateQuorum),
+		MaxLovelaceSupply: uint64(genesisConfig.MaxLovelaceSupply),
+	}
+	return resp, nil
+}
 
 // handleLocalStateQueryGenesisConfig godoc
 //
-//	@Summary	Query Genesis Config
-//	@Tags		localstatequery
-//	@Produce	json
-//	@Success	200	{object}	responseLocalStateQueryGenesisConfig
-//	@Failure	500	{object}	responseApiError
-//	@Router		/localstatequery/genesis-config [get]
-//
-//nolint:unused
+//	@Summary		Query Genesis Config
+//	@Description	Query the Shelley genesis config
+//	@Tags			localstatequery
+//	@Produce		json
+//	@Success		200	{object}	responseLocalStateQueryGenesisConfig
+//	@Failure		500	{object}	responseApiError
+//	@Router			/localstatequery/genesis-config [get]
 func handleLocalStateQueryGenesisConfig(c *gin.Context) {
-	// Connect to node
-	oConn, err := node.GetConnection(nil)
+	// Lease a node connection from the pool
+	lease, err := node.AcquireConnection(c.Request.Context())
 	if err != nil {
 		c.JSON(500, apiError(err.Error()))
 		return
 	}
-	// Async error handler
-	go func() {
-		err, ok := <-oConn.ErrorChan()
-		if !ok {
-			return
-		}
-		c.JSON(500, apiError(err.Error()))
-	}()
-	defer func() {
-		// Close Ouroboros connection
-		oConn.Close()
-	}()
-	// Start client
-	oConn.LocalStateQuery().Client.Start()
+	defer lease.Release()
 
 	// Get genesisConfig
-	genesisConfig, err := oConn.LocalStateQuery().Client.GetGenesisConfig()
+	genesisConfig, err := lease.LocalStateQuery().GetGenesisConfig()
 	if err != nil {
 		c.JSON(500, apiError(err.Error()))
 		return
 	}
 
 	// Create response
-	//resp := responseLocalStateQueryGenesisConfig{
-	//}
-	c.JSON(200, genesisConfig)
+	resp, err := newGenesisConfigResponse(genesisConfig)
+	if err != nil {
+		c.JSON(500, apiError(err.Error()))
+		return
+	}
+	c.JSON(200, resp)
 }
 
 type responseLocalStateQuerySearchUTxOsByAsset struct {
@@ -385,11 +636,14 @@ type responseLocalStateQuerySearchUTxOsByAsset struct {
 }
 
 type utxoItem struct {
-	TxHash  string `json:"tx_hash"`
-	Index   uint32 `json:"index"`
-	Address string `json:"address"`
-	Amount  uint64 `json:"amount"           format:"int64" minimum:"0" example:"1000000"`
-	Assets  any    `json:"assets,omitempty"                                              swaggertype:"array,object"`
+	TxHash          string               `json:"tx_hash"`
+	Index           uint32               `json:"index"`
+	Address         string               `json:"address"`
+	Amount          uint64               `json:"amount"                     format:"int64" minimum:"0" example:"1000000"`
+	Assets          any                  `json:"assets,omitempty"                                                        swaggertype:"array,object"`
+	DatumHash       string               `json:"datum_hash,omitempty"`
+	Datum           string               `json:"datum,omitempty"`
+	ReferenceScript *utxoReferenceScript `json:"reference_script,omitempty"`
 }
 
 // handleLocalStateQuerySearchUTxOsByAsset godoc
@@ -470,33 +724,20 @@ func searchUTxOsByAsset(c *gin.Context, maxResults int) {
 	// requested address(es), so they are always returned in full.
 	limitResults := len(addrs) == 0 && maxResults > 0
 
-	// Connect to node
-	oConn, err := node.GetConnection(nil)
+	// Lease a node connection from the pool
+	lease, err := node.AcquireConnection(c.Request.Context())
 	if err != nil {
 		c.JSON(500, apiError(err.Error()))
 		return
 	}
-	// Async error handler
-	go func() {
-		err, ok := <-oConn.ErrorChan()
-		if !ok {
-			return
-		}
-		c.JSON(500, apiError(err.Error()))
-	}()
-	defer func() {
-		// Close Ouroboros connection
-		oConn.Close()
-	}()
-	// Start client
-	oConn.LocalStateQuery().Client.Start()
+	defer lease.Release()
 
 	// Get UTxOs (either by address or whole set)
 	var utxos *localstatequery.UTxOsResult
 	if len(addrs) > 0 {
-		utxos, err = oConn.LocalStateQuery().Client.GetUTxOByAddress(addrs)
+		utxos, err = lease.LocalStateQuery().GetUTxOByAddress(addrs)
 	} else {
-		utxos, err = oConn.LocalStateQuery().Client.GetUTxOWhole()
+		utxos, err = lease.LocalStateQuery().GetUTxOWhole()
 	}
 	if err != nil {
 		c.JSON(500, apiError(err.Error()))
@@ -541,13 +782,15 @@ func filterUTxOsByAssetResults(
 			if limitResults && len(results) >= maxResults {
 				return results, true
 			}
-			item := utxoItem{
-				TxHash:  hex.EncodeToString(utxoId.Hash[:]),
-				Index:   uint32(utxoId.Idx), // #nosec G115
-				Address: output.Address().String(),
-				Amount:  output.Amount().Uint64(),
-				Assets:  assets,
-			}
+			item := newUtxoItem(
+				ledger.Utxo{
+					Id: ledger.ShelleyTransactionInput{
+						TxId:        utxoId.Hash,
+						OutputIndex: uint32(utxoId.Idx), // #nosec G115
+					},
+					Output: output,
+				},
+			)
 			results = append(results, item)
 		}
 	}
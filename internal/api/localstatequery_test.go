@@ -15,14 +15,17 @@
 package api
 
 import (
+	"encoding/hex"
 	"math/big"
 	"net/http"
 	"net/http/httptest"
 	"strings"
 	"testing"
 
+	"github.com/blinklabs-io/cardano-node-api/internal/node"
 	"github.com/blinklabs-io/gouroboros/cbor"
 	"github.com/blinklabs-io/gouroboros/ledger"
+	"github.com/blinklabs-io/gouroboros/ledger/babbage"
 	lcommon "github.com/blinklabs-io/gouroboros/ledger/common"
 	"github.com/blinklabs-io/gouroboros/protocol/localstatequery"
 	"github.com/gin-gonic/gin"
@@ -171,3 +174,238 @@ func testOutputWithAsset(
 		},
 	}
 }
+
+func TestNewEraHistoryResponse(t *testing.T) {
+	var byron localstatequery.EraHistoryResult
+	byron.End.SlotNo = 43200
+	byron.End.EpochNo = 2
+	byron.Params.EpochLength = 21600
+	byron.Params.SlotLength = 20000
+	var shelley localstatequery.EraHistoryResult
+	shelley.Begin.SlotNo = 43200
+	shelley.Begin.EpochNo = 2
+	shelley.Params.EpochLength = 432000
+	shelley.Params.SlotLength = 1000
+
+	resp, err := newEraHistoryResponse(
+		0,
+		[]localstatequery.EraHistoryResult{byron, shelley},
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(resp.Eras) != 2 {
+		t.Fatalf("expected 2 eras, got %d", len(resp.Eras))
+	}
+	if resp.Eras[0].Era != "Byron" || resp.Eras[1].Era != "Shelley" {
+		t.Fatalf(
+			"unexpected era names: %s, %s",
+			resp.Eras[0].Era,
+			resp.Eras[1].Era,
+		)
+	}
+	if resp.Eras[0].End == nil ||
+		resp.Eras[0].End.POSIXTime != resp.Eras[1].Start.POSIXTime {
+		t.Fatalf("expected Shelley to start at the end of Byron")
+	}
+	if resp.Eras[1].End != nil {
+		t.Fatalf("expected Shelley era to be open-ended")
+	}
+}
+
+func TestSlotToTimeRequiresSlot(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(
+		http.MethodGet,
+		"/localstatequery/slot-to-time?slot=abc",
+		nil,
+	)
+
+	handleLocalStateQuerySlotToTime(c)
+
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("expected status 400, got %d", w.Code)
+	}
+}
+
+func TestNewGenesisConfigResponse(t *testing.T) {
+	genesisConfig := &localstatequery.GenesisConfigResult{
+		NetworkMagic:      764824073,
+		NetworkId:         1,
+		ActiveSlotsCoeff:  []any{uint64(1), uint64(20)},
+		SecurityParam:     2160,
+		EpochLength:       432000,
+		SlotsPerKESPeriod: 129600,
+		MaxKESEvolutions:  62,
+		SlotLength:        1000000,
+		UpdateQuorum:      5,
+		MaxLovelaceSupply: 45000000000000000,
+	}
+	genesisConfig.Start.Year.SetInt64(2017)
+	genesisConfig.Start.Day = 266
+	genesisConfig.Start.Picoseconds.SetInt64(78291000000000000)
+
+	resp, err := newGenesisConfigResponse(genesisConfig)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if resp.SystemStart.Format("2006-01-02T15:04:05Z") != "2017-09-23T21:44:51Z" {
+		t.Fatalf("unexpected system start: %s", resp.SystemStart)
+	}
+	if resp.ActiveSlotsCoeff.Numerator != 1 ||
+		resp.ActiveSlotsCoeff.Denominator != 20 {
+		t.Fatalf(
+			"unexpected active slots coefficient: %+v",
+			resp.ActiveSlotsCoeff,
+		)
+	}
+	if resp.SlotLengthMs != 1000 {
+		t.Fatalf("expected slot length of 1000ms, got %d", resp.SlotLengthMs)
+	}
+	if resp.SecurityParam != 2160 || resp.EpochLength != 432000 {
+		t.Fatalf("unexpected response: %+v", resp)
+	}
+	if resp.MaxLovelaceSupply != 45000000000000000 {
+		t.Fatalf(
+			"unexpected max lovelace supply: %d",
+			resp.MaxLovelaceSupply,
+		)
+	}
+}
+
+func TestNewGenesisConfigResponseBadActiveSlotsCoeff(t *testing.T) {
+	genesisConfig := &localstatequery.GenesisConfigResult{
+		ActiveSlotsCoeff: []any{uint64(1)},
+	}
+	if _, err := newGenesisConfigResponse(genesisConfig); err == nil {
+		t.Fatalf("expected error for malformed active slots coefficient")
+	}
+}
+
+func TestNewUtxoItem(t *testing.T) {
+	var policyId ledger.Blake2b224
+	output := testOutputWithAsset(t, policyId, []byte("asset"))
+	datumHash := make([]byte, 32)
+	datumHash[0] = 0xab
+	datumOptionCbor, err := cbor.Encode([]any{0, datumHash})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	var datumOption babbage.BabbageTransactionOutputDatumOption
+	if _, err := cbor.Decode(datumOptionCbor, &datumOption); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	output.DatumOption = &datumOption
+	script := lcommon.PlutusV2Script{0x01, 0x02}
+	output.TxOutScriptRef = &lcommon.ScriptRef{
+		Type:   lcommon.ScriptRefTypePlutusV2,
+		Script: script,
+	}
+	txIn, err := node.ParseTxIn(strings.Repeat("cd", 32) + "#7")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	item := newUtxoItem(ledger.Utxo{Id: txIn, Output: output})
+
+	if item.TxHash != strings.Repeat("cd", 32) || item.Index != 7 {
+		t.Fatalf("unexpected UTxO ref: %s#%d", item.TxHash, item.Index)
+	}
+	if item.Amount != 1000000 {
+		t.Fatalf("unexpected amount: %d", item.Amount)
+	}
+	if item.Assets == nil {
+		t.Fatal("expected assets to be set")
+	}
+	if item.DatumHash != hex.EncodeToString(datumHash) {
+		t.Fatalf("unexpected datum hash: %s", item.DatumHash)
+	}
+	if item.Datum != "" {
+		t.Fatalf("expected no inline datum, got %s", item.Datum)
+	}
+	if item.ReferenceScript == nil {
+		t.Fatal("expected reference script to be set")
+	}
+	if item.ReferenceScript.Type != "PlutusV2" ||
+		item.ReferenceScript.Hash != script.Hash().String() ||
+		item.ReferenceScript.Cbor != "0102" {
+		t.Fatalf("unexpected reference script: %+v", item.ReferenceScript)
+	}
+}
+
+func TestHandleLocalStateQueryUTxOsByAddressValidation(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	testDefs := []struct {
+		query       string
+		expectedErr string
+	}{
+		{query: "", expectedErr: "address parameter is required"},
+		{query: "?address=zz", expectedErr: "invalid address"},
+	}
+	for _, testDef := range testDefs {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(
+			http.MethodGet,
+			"/localstatequery/utxos/by-address"+testDef.query,
+			nil,
+		)
+
+		handleLocalStateQueryUTxOsByAddress(c)
+
+		if w.Code != http.StatusBadRequest {
+			t.Fatalf("expected status 400, got %d", w.Code)
+		}
+		if !strings.Contains(w.Body.String(), testDef.expectedErr) {
+			t.Fatalf(
+				"expected error containing %q, got %s",
+				testDef.expectedErr,
+				w.Body.String(),
+			)
+		}
+	}
+}
+
+func TestHandleLocalStateQueryUTxOsByTxInValidation(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	testDefs := []struct {
+		body        string
+		expectedErr string
+	}{
+		{body: `{}`, expectedErr: "TxIns"},
+		{body: `{"txins":[]}`, expectedErr: "TxIns"},
+		{body: `{"txins":["abcd#0"]}`, expectedErr: "invalid transaction hash"},
+		{
+			body:        `{"txins":["` + strings.Repeat("cd", 32) + `"]}`,
+			expectedErr: "invalid transaction input",
+		},
+	}
+	for _, testDef := range testDefs {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(
+			http.MethodPost,
+			"/localstatequery/utxos/by-txin",
+			strings.NewReader(testDef.body),
+		)
+		c.Request.Header.Set("Content-Type", "application/json")
+
+		handleLocalStateQueryUTxOsByTxIn(c)
+
+		if w.Code != http.StatusBadRequest {
+			t.Fatalf("expected status 400, got %d", w.Code)
+		}
+		if !strings.Contains(w.Body.String(), testDef.expectedErr) {
+			t.Fatalf(
+				"expected error containing %q, got %s",
+				testDef.expectedErr,
+				w.Body.String(),
+			)
+		}
+	}
+}
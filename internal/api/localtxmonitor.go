@@ -46,28 +46,15 @@ type responseLocalTxMonitorSizes struct {
 //	@Failure	500	{object}	responseApiError
 //	@Router		/localtxmonitor/sizes [get]
 func handleLocalTxMonitorSizes(c *gin.Context) {
-	// Connect to node
-	oConn, err := node.GetConnection(nil)
+	// Lease a node connection from the pool
+	lease, err := node.AcquireConnection(c.Request.Context())
 	if err != nil {
 		c.JSON(500, apiError(err.Error()))
 		return
 	}
-	// Async error handler
-	go func() {
-		err, ok := <-oConn.ErrorChan()
-		if !ok {
-			return
-		}
-		c.JSON(500, apiError(err.Error()))
-	}()
-	defer func() {
-		// Close Ouroboros connection
-		oConn.Close()
-	}()
-	// Start client
-	oConn.LocalTxMonitor().Client.Start()
+	defer lease.Release()
 	// Get sizes
-	capacity, size, txCount, err := oConn.LocalTxMonitor().Client.GetSizes()
+	capacity, size, txCount, err := lease.LocalTxMonitor().GetSizes()
 	if err != nil {
 		c.JSON(500, apiError(err.Error()))
 		return
@@ -106,33 +93,20 @@ func handleLocalTxMonitorHasTx(c *gin.Context) {
 		c.JSON(http.StatusBadRequest, apiError(err.Error()))
 		return
 	}
-	// Connect to node
-	oConn, err := node.GetConnection(nil)
+	// Lease a node connection from the pool
+	lease, err := node.AcquireConnection(c.Request.Context())
 	if err != nil {
 		c.JSON(500, apiError(err.Error()))
 		return
 	}
-	// Async error handler
-	go func() {
-		err, ok := <-oConn.ErrorChan()
-		if !ok {
-			return
-		}
-		c.JSON(500, apiError(err.Error()))
-	}()
-	defer func() {
-		// Close Ouroboros connection
-		oConn.Close()
-	}()
-	// Start client
-	oConn.LocalTxMonitor().Client.Start()
+	defer lease.Release()
 	// Make the call to the node
 	txHash, err := hex.DecodeString(req.TxHash)
 	if err != nil {
 		c.JSON(500, apiError(err.Error()))
 		return
 	}
-	hasTx, err := oConn.LocalTxMonitor().Client.HasTx(txHash)
+	hasTx, err := lease.LocalTxMonitor().HasTx(txHash)
 	if err != nil {
 		c.JSON(500, apiError(err.Error()))
 		return
@@ -159,30 +133,17 @@ type responseLocalTxMonitorTxs struct {
 //	@Failure	500	{object}	responseApiError
 //	@Router		/localtxmonitor/txs [get]
 func handleLocalTxMonitorTxs(c *gin.Context) {
-	// Connect to node
-	oConn, err := node.GetConnection(nil)
+	// Lease a node connection from the pool
+	lease, err := node.AcquireConnection(c.Request.Context())
 	if err != nil {
 		c.JSON(500, apiError(err.Error()))
 		return
 	}
-	// Async error handler
-	go func() {
-		err, ok := <-oConn.ErrorChan()
-		if !ok {
-			return
-		}
-		c.JSON(500, apiError(err.Error()))
-	}()
-	defer func() {
-		// Close Ouroboros connection
-		oConn.Close()
-	}()
-	// Start client
-	oConn.LocalTxMonitor().Client.Start()
+	defer lease.Release()
 	// Collect TX hashes
 	resp := []responseLocalTxMonitorTxs{}
 	for {
-		txRawBytes, err := oConn.LocalTxMonitor().Client.NextTx()
+		txRawBytes, err := lease.LocalTxMonitor().NextTx()
 		if err != nil {
 			c.JSON(500, apiError(err.Error()))
 			return
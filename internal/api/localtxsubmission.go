@@ -15,33 +15,48 @@
 package api
 
 import (
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"io"
+	"net/http"
+	"strconv"
 
 	"github.com/blinklabs-io/cardano-node-api/internal/config"
 	"github.com/blinklabs-io/cardano-node-api/internal/logging"
-	"github.com/blinklabs-io/gouroboros/protocol/localtxsubmission"
-	"github.com/blinklabs-io/tx-submit-api/submit"
+	"github.com/blinklabs-io/cardano-node-api/internal/node"
+	"github.com/blinklabs-io/gouroboros/ledger"
 	"github.com/gin-gonic/gin"
 )
 
+type responseLocalTxSubmissionError struct {
+	Msg      string                     `json:"msg"                example:"transaction rejected by node"`
+	Failures []node.TxValidationFailure `json:"failures,omitempty"`
+	Reason   *node.TxRejectReason       `json:"reason,omitempty"`
+}
+
 func configureLocalTxSubmissionRoutes(apiGroup *gin.RouterGroup) {
 	group := apiGroup.Group("/localtxsubmission")
 	group.POST("/tx", handleLocalSubmitTx)
+	group.POST("/txs", handleLocalSubmitTxs)
 }
 
 // handleLocalSubmitTx godoc
 //
 //	@Summary		Submit Tx
 //	@Tags			localtxsubmission
-//	@Description	Submit an already serialized transaction to the network.
+//	@Description	Submit an already serialized transaction to the network. When validation is enabled, a transaction which breaks the phase-1 ledger rules is rejected with the list of failures and isn't sent to the node. When the node rejects the transaction, the decoded ledger failures are returned as the reason, or the raw CBOR reason when requested with an Accept header of application/cbor. When waiting, the transaction is tracked after submission and each stage it reaches is sent as a server-sent status event. The stages are accepted, mempool, included (with the block slot and hash), confirmed (with the number of blocks on top), rolled_back and dropped. The stream ends once the transaction has the requested number of confirmations or is dropped. If tracking fails, an error event is sent before the stream ends.
 //	@Produce		json
-//	@Param			Content-Type	header		string	true	"Content type"	Enums(application/cbor)
-//	@Success		202				{object}	string	"Ok"
-//	@Failure		400				{object}	string	"Bad Request"
-//	@Failure		415				{object}	string	"Unsupported Media Type"
-//	@Failure		500				{object}	string	"Server Error"
+//	@Produce		text/event-stream
+//	@Param			Content-Type	header		string							true	"Content type"	Enums(application/cbor)
+//	@Param			validate		query		bool							false	"Validate the transaction before submitting it (default from config)"
+//	@Param			wait			query		bool							false	"Stream the stages of the transaction after submitting it"
+//	@Param			confirmations	query		int								false	"Number of blocks on top of the including block to wait for (default 0)"
+//	@Success		200				{object}	node.TxStatus					"Status events (when waiting)"
+//	@Success		202				{object}	string							"Ok"
+//	@Failure		400				{object}	responseLocalTxSubmissionError	"Bad Request"
+//	@Failure		415				{object}	string							"Unsupported Media Type"
+//	@Failure		500				{object}	string							"Server Error"
 //	@Router			/localtxsubmission/tx [post]
 func handleLocalSubmitTx(c *gin.Context) {
 	// First, initialize our configuration and loggers
@@ -70,46 +85,207 @@ func handleLocalSubmitTx(c *gin.Context) {
 			logger.Error("failed to close request body:", "error", err)
 		}
 	}
+	// Validate the transaction against the ledger rules, if enabled
+	validate := cfg.Submit.Validate
+	if validateParam, ok := c.GetQuery("validate"); ok {
+		validate, err = strconv.ParseBool(validateParam)
+		if err != nil {
+			c.JSON(400, "invalid validate query parameter")
+			return
+		}
+	}
+	// Track the transaction after submitting it, if requested
+	wait := false
+	if waitParam, ok := c.GetQuery("wait"); ok {
+		wait, err = strconv.ParseBool(waitParam)
+		if err != nil {
+			c.JSON(400, "invalid wait query parameter")
+			return
+		}
+	}
+	var confirmations uint64
+	if confirmationsParam, ok := c.GetQuery("confirmations"); ok {
+		confirmations, err = strconv.ParseUint(confirmationsParam, 10, 64)
+		if err != nil {
+			c.JSON(400, "invalid confirmations query parameter")
+			return
+		}
+	}
+	tx, err := decodeTx(txRawBytes)
+	if err != nil {
+		c.JSON(400, err.Error())
+		return
+	}
+	if validate {
+		// Lease a node connection from the pool
+		lease, err := node.AcquireConnection(c.Request.Context())
+		if err != nil {
+			c.JSON(500, err.Error())
+			return
+		}
+		err = node.ValidateTx(lease.LocalStateQuery(), tx)
+		lease.Release()
+		if err != nil {
+			var validationErr *node.TxValidationError
+			if errors.As(err, &validationErr) {
+				c.JSON(400, responseLocalTxSubmissionError{
+					Msg:      validationErr.Error(),
+					Failures: validationErr.Failures,
+				})
+			} else {
+				logger.Error("failed to validate transaction:", "error", err)
+				c.JSON(500, "failed to validate transaction")
+			}
+			return
+		}
+	}
+	// Start following the chain before submitting, so that the block which
+	// includes the transaction can't be missed
+	var tracker *node.TxTracker
+	if wait {
+		tracker = node.NewTxTracker(tx.Hash().Bytes(), confirmations)
+		if err := tracker.Start(c.Request.Context()); err != nil {
+			logger.Error("failed to start transaction tracker:", "error", err)
+			c.JSON(500, "failed to start transaction tracker")
+			return
+		}
+		defer tracker.Stop()
+	}
+	// Lease a node connection from the pool
+	lease, err := node.AcquireConnection(c.Request.Context())
+	if err != nil {
+		c.JSON(500, err.Error())
+		return
+	}
 	// Send TX
-	errorChan := make(chan error)
-	submitConfig := &submit.Config{
-		ErrorChan:    errorChan,
-		NetworkMagic: cfg.Node.NetworkMagic,
-		NodeAddress:  cfg.Node.Address,
-		NodePort:     cfg.Node.Port,
-		SocketPath:   cfg.Node.SocketPath,
-		Timeout:      cfg.Node.Timeout,
-	}
-	txHash, err := submit.SubmitTx(submitConfig, txRawBytes)
+	err = lease.LocalTxSubmission().SubmitTx(
+		uint16(tx.Type()), // #nosec G115
+		txRawBytes,
+	)
+	lease.Release()
 	if err != nil {
 		if c.GetHeader("Accept") == "application/cbor" {
-			var txRejectErr *localtxsubmission.TransactionRejectedError
-			if errors.As(err, &txRejectErr) {
-				c.Data(400, "application/cbor", txRejectErr.ReasonCbor)
+			if reasonCbor, ok := node.TxRejectReasonCbor(err); ok {
+				c.Data(400, "application/cbor", reasonCbor)
 			} else {
 				c.Data(500, "application/cbor", []byte{})
 			}
 		} else {
-			if err.Error() != "" {
-				c.JSON(400, err.Error())
+			if reason, ok := node.TxRejectReasonFromError(err); ok {
+				c.JSON(400, responseLocalTxSubmissionError{
+					Msg:    err.Error(),
+					Reason: reason,
+				})
 			} else {
-				c.JSON(400, fmt.Sprintf("%s", err))
+				logger.Error("failure communicating with node:", "error", err)
+				c.JSON(500, "failure communicating with node")
 			}
 		}
 		// _ = ginmetrics.GetMonitor().GetMetric("tx_submit_fail_count").Inc(nil)
 		return
 	}
-	// Start async error handler
-	go func() {
-		err, ok := <-errorChan
-		if ok {
-			logger.Error("failure communicating with node:", "error", err)
-			c.JSON(500, "failure communicating with node")
-			// _ = ginmetrics.GetMonitor().GetMetric("tx_submit_fail_count").Inc(nil)
+	// Stream the stages of the transaction until it's done or the client
+	// goes away
+	if wait {
+		c.SSEvent("status", node.TxStatus{
+			TxHash: tx.Hash().String(),
+			Stage:  node.TxStageAccepted,
+		})
+		c.Writer.Flush()
+		for status := range tracker.StatusChan() {
+			c.SSEvent("status", status)
+			c.Writer.Flush()
 		}
-	}()
+		// The response has already started, so a failure is reported as an
+		// event rather than a status code
+		if err := tracker.Err(); err != nil {
+			c.SSEvent("error", apiError(err.Error()))
+			c.Writer.Flush()
+		}
+		return
+	}
 	// Return transaction ID
-	c.JSON(202, txHash)
+	c.JSON(202, tx.Hash().String())
 	// Increment custom metric
 	// _ = ginmetrics.GetMonitor().GetMetric("tx_submit_count").Inc(nil)
 }
+
+type requestLocalSubmitTxs struct {
+	Transactions    []string `json:"transactions"      binding:"required,min=1,max=100" example:"84a400d9010281825820..."`
+	ContinueOnError bool     `json:"continue_on_error"`
+}
+
+type responseLocalSubmitTxs struct {
+	Results []node.TxSubmitResult `json:"results"`
+}
+
+// handleLocalSubmitTxs godoc
+//
+//	@Summary		Submit Tx Batch
+//	@Tags			localtxsubmission
+//	@Description	Submit an ordered list of already serialized transactions (hex encoded CBOR) to the network over a single node connection. Later transactions may spend the outputs of earlier ones, so the transactions aren't validated before submission. After a failure, the remaining transactions are skipped unless continue_on_error is set. The hash and status of each transaction are returned in the order submitted.
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body		requestLocalSubmitTxs	true	"Transactions (hex encoded CBOR)"
+//	@Success		200		{object}	responseLocalSubmitTxs
+//	@Failure		400		{object}	responseApiError
+//	@Failure		500		{object}	responseApiError
+//	@Router			/localtxsubmission/txs [post]
+func handleLocalSubmitTxs(c *gin.Context) {
+	var req requestLocalSubmitTxs
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, apiError(err.Error()))
+		return
+	}
+	// Decode all transactions before submitting any of them
+	txs := make([]ledger.Transaction, 0, len(req.Transactions))
+	for idx, txHex := range req.Transactions {
+		tx, err := decodeTxHex(txHex)
+		if err != nil {
+			c.JSON(
+				http.StatusBadRequest,
+				apiError(fmt.Sprintf("transaction %d: %s", idx, err)),
+			)
+			return
+		}
+		txs = append(txs, tx)
+	}
+
+	// Lease a node connection from the pool
+	lease, err := node.AcquireConnection(c.Request.Context())
+	if err != nil {
+		c.JSON(500, apiError(err.Error()))
+		return
+	}
+	defer lease.Release()
+
+	// Submit transactions
+	resp := responseLocalSubmitTxs{
+		Results: node.SubmitTxBatch(
+			lease.LocalTxSubmission().SubmitTx,
+			txs,
+			req.ContinueOnError,
+		),
+	}
+	c.JSON(200, resp)
+}
+
+func decodeTxHex(txHex string) (ledger.Transaction, error) {
+	txRawBytes, err := hex.DecodeString(txHex)
+	if err != nil {
+		return nil, fmt.Errorf("invalid hex: %w", err)
+	}
+	return decodeTx(txRawBytes)
+}
+
+func decodeTx(txRawBytes []byte) (ledger.Transaction, error) {
+	txType, err := ledger.DetermineTransactionType(txRawBytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode transaction: %w", err)
+	}
+	tx, err := ledger.NewTransactionFromCbor(txType, txRawBytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode transaction: %w", err)
+	}
+	return tx, nil
+}
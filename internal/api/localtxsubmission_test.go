@@ -0,0 +1,89 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestHandleLocalSubmitTxsValidation(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	configureLocalTxSubmissionRoutes(router.Group("/"))
+
+	testDefs := []string{
+		`{}`,
+		`{"transactions":[]}`,
+		`{"transactions":["zz"]}`,
+		// Valid hex, but not a transaction
+		`{"transactions":["deadbeef"]}`,
+	}
+	for _, testDef := range testDefs {
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(
+			http.MethodPost,
+			"/localtxsubmission/txs",
+			strings.NewReader(testDef),
+		)
+		req.Header.Set("Content-Type", "application/json")
+		router.ServeHTTP(w, req)
+		if w.Code != http.StatusBadRequest {
+			t.Fatalf(
+				"%s: expected status 400, got %d: %s",
+				testDef,
+				w.Code,
+				w.Body.String(),
+			)
+		}
+	}
+}
+
+func TestHandleLocalSubmitTxQueryValidation(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	configureLocalTxSubmissionRoutes(router.Group("/"))
+
+	testDefs := []string{
+		"/localtxsubmission/tx?validate=maybe",
+		"/localtxsubmission/tx?wait=maybe",
+		"/localtxsubmission/tx?wait=true&confirmations=-1",
+		// Waiting needs the transaction hash
+		"/localtxsubmission/tx?wait=true",
+	}
+	for _, testDef := range testDefs {
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(
+			http.MethodPost,
+			testDef,
+			bytes.NewReader([]byte{0xde, 0xad, 0xbe, 0xef}),
+		)
+		req.Header.Set("Content-Type", "application/cbor")
+		router.ServeHTTP(w, req)
+		if w.Code != http.StatusBadRequest {
+			t.Fatalf(
+				"%s: expected status 400, got %d: %s",
+				testDef,
+				w.Code,
+				w.Body.String(),
+			)
+		}
+	}
+}
@@ -0,0 +1,361 @@
+// Copyright 2025 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"maps"
+	"net/http"
+	"slices"
+
+	"github.com/blinklabs-io/cardano-node-api/internal/node"
+	"github.com/blinklabs-io/gouroboros/cbor"
+	"github.com/blinklabs-io/gouroboros/ledger"
+	lcommon "github.com/blinklabs-io/gouroboros/ledger/common"
+	"github.com/blinklabs-io/gouroboros/protocol/localstatequery"
+	"github.com/gin-gonic/gin"
+)
+
+func configurePoolRoutes(group *gin.RouterGroup) {
+	group.GET("/stake-distribution", handleLocalStateQueryStakeDistribution)
+	group.GET("/pools", handleLocalStateQueryPools)
+	group.GET("/pools/retiring", handleLocalStateQueryPoolRetirements)
+	group.GET("/pools/:pool_id", handleLocalStateQueryPoolParams)
+}
+
+type stakeDistributionItem struct {
+	PoolId        string   `json:"pool_id"        example:"pool1pu5jlj4q9w9jlxeu370a3c9myx47md5j5m2str0naunn2q3lkdy"`
+	StakeFraction rational `json:"stake_fraction"`
+	VrfKeyHash    string   `json:"vrf_key_hash"`
+}
+
+type responseLocalStateQueryStakeDistribution struct {
+	responsePagination
+	Pools []stakeDistributionItem `json:"pools"`
+}
+
+// handleLocalStateQueryStakeDistribution godoc
+//
+//	@Summary		Query Stake Distribution
+//	@Description	Get the relative stake of each registered pool, ordered by pool ID
+//	@Tags			localstatequery
+//	@Produce		json
+//	@Param			page		query		int	false	"Page number"	default(1)		minimum(1)
+//	@Param			page_size	query		int	false	"Page size"		default(100)	minimum(1)	maximum(1000)
+//	@Success		200			{object}	responseLocalStateQueryStakeDistribution
+//	@Failure		400			{object}	responseApiError
+//	@Failure		500			{object}	responseApiError
+//	@Router			/localstatequery/stake-distribution [get]
+func handleLocalStateQueryStakeDistribution(c *gin.Context) {
+	var req requestPagination
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(http.StatusBadRequest, apiError(err.Error()))
+		return
+	}
+
+	// Lease a node connection from the pool
+	lease, err := node.AcquireConnection(c.Request.Context())
+	if err != nil {
+		c.JSON(500, apiError(err.Error()))
+		return
+	}
+	defer lease.Release()
+
+	// Get stake distribution
+	stakeDistribution, err := lease.LocalStateQuery().GetStakeDistribution()
+	if err != nil {
+		c.JSON(500, apiError(err.Error()))
+		return
+	}
+
+	// Create response
+	poolIds, paging := paginate(
+		node.SortPoolIds(slices.Collect(maps.Keys(stakeDistribution.Results))),
+		req,
+	)
+	resp := responseLocalStateQueryStakeDistribution{
+		responsePagination: paging,
+		Pools:              make([]stakeDistributionItem, 0, len(poolIds)),
+	}
+	for _, poolId := range poolIds {
+		tmpItem := stakeDistribution.Results[poolId]
+		resp.Pools = append(
+			resp.Pools,
+			stakeDistributionItem{
+				PoolId:        poolId.String(),
+				StakeFraction: newRational(tmpItem.StakeFraction),
+				VrfKeyHash:    tmpItem.VrfHash.String(),
+			},
+		)
+	}
+	c.JSON(200, resp)
+}
+
+type responseLocalStateQueryPools struct {
+	responsePagination
+	Pools []string `json:"pools" example:"pool1pu5jlj4q9w9jlxeu370a3c9myx47md5j5m2str0naunn2q3lkdy"`
+}
+
+// handleLocalStateQueryPools godoc
+//
+//	@Summary		Query Stake Pools
+//	@Description	Get the IDs of all registered stake pools, ordered by pool ID
+//	@Tags			localstatequery
+//	@Produce		json
+//	@Param			page		query		int	false	"Page number"	default(1)		minimum(1)
+//	@Param			page_size	query		int	false	"Page size"		default(100)	minimum(1)	maximum(1000)
+//	@Success		200			{object}	responseLocalStateQueryPools
+//	@Failure		400			{object}	responseApiError
+//	@Failure		500			{object}	responseApiError
+//	@Router			/localstatequery/pools [get]
+func handleLocalStateQueryPools(c *gin.Context) {
+	var req requestPagination
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(http.StatusBadRequest, apiError(err.Error()))
+		return
+	}
+
+	// Lease a node connection from the pool
+	lease, err := node.AcquireConnection(c.Request.Context())
+	if err != nil {
+		c.JSON(500, apiError(err.Error()))
+		return
+	}
+	defer lease.Release()
+
+	// Get stake pools
+	stakePools, err := lease.LocalStateQuery().GetStakePools()
+	if err != nil {
+		c.JSON(500, apiError(err.Error()))
+		return
+	}
+
+	// Create response
+	poolIds, paging := paginate(
+		node.SortPoolIds(slices.Clone(stakePools.Results)),
+		req,
+	)
+	resp := responseLocalStateQueryPools{
+		responsePagination: paging,
+		Pools:              make([]string, 0, len(poolIds)),
+	}
+	for _, poolId := range poolIds {
+		resp.Pools = append(resp.Pools, poolId.String())
+	}
+	c.JSON(200, resp)
+}
+
+type poolRelay struct {
+	Type     string `json:"type"               example:"single_host_name" enums:"single_host_address,single_host_name,multi_host_name"`
+	Port     uint32 `json:"port,omitempty"     example:"3001"`
+	Ipv4     string `json:"ipv4,omitempty"`
+	Ipv6     string `json:"ipv6,omitempty"`
+	Hostname string `json:"hostname,omitempty" example:"relay.example.com"`
+}
+
+type poolMetadata struct {
+	Url  string `json:"url"  example:"https://example.com/pool.json"`
+	Hash string `json:"hash"`
+}
+
+type responseLocalStateQueryPoolParams struct {
+	PoolId        string        `json:"pool_id"                 example:"pool1pu5jlj4q9w9jlxeu370a3c9myx47md5j5m2str0naunn2q3lkdy"`
+	VrfKeyHash    string        `json:"vrf_key_hash"`
+	Pledge        uint64        `json:"pledge"                  example:"100000000000"                                             format:"int64" minimum:"0"`
+	Cost          uint64        `json:"cost"                    example:"340000000"                                                format:"int64" minimum:"0"`
+	Margin        rational      `json:"margin"`
+	RewardAccount string        `json:"reward_account"          example:"stake1u9ylzsgxaa6xctf4juup682ar3juj85n8tx3hthnljg47zctvm3rc"`
+	Owners        []string      `json:"owners"`
+	Relays        []poolRelay   `json:"relays"`
+	Metadata      *poolMetadata `json:"metadata,omitempty"`
+	RetiringEpoch *uint64       `json:"retiring_epoch,omitempty" example:"500"                                                      format:"int64" minimum:"0"`
+}
+
+func newPoolRelay(relay lcommon.PoolRelay) poolRelay {
+	var ret poolRelay
+	switch relay.Type {
+	case lcommon.PoolRelayTypeSingleHostAddress:
+		ret.Type = "single_host_address"
+	case lcommon.PoolRelayTypeSingleHostName:
+		ret.Type = "single_host_name"
+	case lcommon.PoolRelayTypeMultiHostName:
+		ret.Type = "multi_host_name"
+	}
+	if relay.Port != nil {
+		ret.Port = *relay.Port
+	}
+	if relay.Ipv4 != nil {
+		ret.Ipv4 = relay.Ipv4.String()
+	}
+	if relay.Ipv6 != nil {
+		ret.Ipv6 = relay.Ipv6.String()
+	}
+	if relay.Hostname != nil {
+		ret.Hostname = *relay.Hostname
+	}
+	return ret
+}
+
+func newPoolParamsResponse(
+	poolId ledger.PoolId,
+	params *localstatequery.PoolStateParams,
+) responseLocalStateQueryPoolParams {
+	ret := responseLocalStateQueryPoolParams{
+		PoolId:        poolId.String(),
+		VrfKeyHash:    params.VrfKeyHash.String(),
+		Pledge:        params.Pledge,
+		Cost:          params.Cost,
+		Margin:        newRational(params.Margin),
+		RewardAccount: params.RewardAccount.String(),
+		Owners:        make([]string, 0, len(params.PoolOwners)),
+		Relays:        make([]poolRelay, 0, len(params.Relays)),
+	}
+	for _, owner := range params.PoolOwners {
+		ret.Owners = append(ret.Owners, owner.String())
+	}
+	for _, relay := range params.Relays {
+		ret.Relays = append(ret.Relays, newPoolRelay(relay))
+	}
+	if params.PoolMetadata != nil {
+		ret.Metadata = &poolMetadata{
+			Url:  params.PoolMetadata.Url,
+			Hash: params.PoolMetadata.MetadataHash.String(),
+		}
+	}
+	return ret
+}
+
+type requestLocalStateQueryPool struct {
+	PoolId string `uri:"pool_id" binding:"required"`
+}
+
+// handleLocalStateQueryPoolParams godoc
+//
+//	@Summary		Query Stake Pool Parameters
+//	@Description	Get the registered parameters of a stake pool, including its pending retirement epoch if any
+//	@Tags			localstatequery
+//	@Produce		json
+//	@Param			pool_id	path		string	true	"Pool ID (bech32 or hex)"
+//	@Success		200		{object}	responseLocalStateQueryPoolParams
+//	@Failure		400		{object}	responseApiError
+//	@Failure		404		{object}	responseApiError
+//	@Failure		500		{object}	responseApiError
+//	@Router			/localstatequery/pools/{pool_id} [get]
+func handleLocalStateQueryPoolParams(c *gin.Context) {
+	var req requestLocalStateQueryPool
+	if err := c.ShouldBindUri(&req); err != nil {
+		c.JSON(http.StatusBadRequest, apiError(err.Error()))
+		return
+	}
+	poolId, err := node.ParsePoolId(req.PoolId)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, apiError(err.Error()))
+		return
+	}
+
+	// Lease a node connection from the pool
+	lease, err := node.AcquireConnection(c.Request.Context())
+	if err != nil {
+		c.JSON(500, apiError(err.Error()))
+		return
+	}
+	defer lease.Release()
+
+	// Get pool state. The pool filter is a Maybe (Set PoolId), which is
+	// encoded as a list containing a single tagged set
+	poolState, err := lease.LocalStateQuery().GetPoolState(
+		[]any{cbor.NewSetType([]ledger.PoolId{poolId}, true)},
+	)
+	if err != nil {
+		c.JSON(500, apiError(err.Error()))
+		return
+	}
+	params, ok := poolState.PState[ledger.Blake2b224(poolId)]
+	if !ok || params == nil {
+		c.JSON(http.StatusNotFound, apiError("pool not found"))
+		return
+	}
+
+	// Create response
+	resp := newPoolParamsResponse(poolId, params)
+	if epoch, ok := poolState.Retiring[ledger.Blake2b224(poolId)]; ok {
+		resp.RetiringEpoch = &epoch
+	}
+	c.JSON(200, resp)
+}
+
+type poolRetirementItem struct {
+	PoolId string `json:"pool_id" example:"pool1pu5jlj4q9w9jlxeu370a3c9myx47md5j5m2str0naunn2q3lkdy"`
+	Epoch  uint64 `json:"epoch"   example:"500"                                                      format:"int64" minimum:"0"`
+}
+
+type responseLocalStateQueryPoolRetirements struct {
+	responsePagination
+	Pools []poolRetirementItem `json:"pools"`
+}
+
+// handleLocalStateQueryPoolRetirements godoc
+//
+//	@Summary		Query Pool Retirements
+//	@Description	Get the stake pools which are scheduled to retire and the epoch of their retirement, ordered by pool ID
+//	@Tags			localstatequery
+//	@Produce		json
+//	@Param			page		query		int	false	"Page number"	default(1)		minimum(1)
+//	@Param			page_size	query		int	false	"Page size"		default(100)	minimum(1)	maximum(1000)
+//	@Success		200			{object}	responseLocalStateQueryPoolRetirements
+//	@Failure		400			{object}	responseApiError
+//	@Failure		500			{object}	responseApiError
+//	@Router			/localstatequery/pools/retiring [get]
+func handleLocalStateQueryPoolRetirements(c *gin.Context) {
+	var req requestPagination
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(http.StatusBadRequest, apiError(err.Error()))
+		return
+	}
+
+	// Lease a node connection from the pool
+	lease, err := node.AcquireConnection(c.Request.Context())
+	if err != nil {
+		c.JSON(500, apiError(err.Error()))
+		return
+	}
+	defer lease.Release()
+
+	// Get pool state
+	poolState, err := lease.LocalStateQuery().GetPoolState(nil)
+	if err != nil {
+		c.JSON(500, apiError(err.Error()))
+		return
+	}
+
+	// Create response
+	poolIds, paging := paginate(
+		node.SortPoolIds(slices.Collect(maps.Keys(poolState.Retiring))),
+		req,
+	)
+	resp := responseLocalStateQueryPoolRetirements{
+		responsePagination: paging,
+		Pools:              make([]poolRetirementItem, 0, len(poolIds)),
+	}
+	for _, poolId := range poolIds {
+		resp.Pools = append(
+			resp.Pools,
+			poolRetirementItem{
+				PoolId: ledger.PoolId(poolId).String(),
+				Epoch:  poolState.Retiring[poolId],
+			},
+		)
+	}
+	c.JSON(200, resp)
+}
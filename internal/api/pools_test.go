@@ -0,0 +1,176 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/blinklabs-io/gouroboros/cbor"
+	"github.com/blinklabs-io/gouroboros/ledger"
+	lcommon "github.com/blinklabs-io/gouroboros/ledger/common"
+	"github.com/blinklabs-io/gouroboros/protocol/localstatequery"
+	"github.com/gin-gonic/gin"
+)
+
+func TestPaginate(t *testing.T) {
+	items := []int{1, 2, 3, 4, 5}
+	testDefs := []struct {
+		page     int
+		pageSize int
+		expected []int
+	}{
+		{page: 1, pageSize: 2, expected: []int{1, 2}},
+		{page: 3, pageSize: 2, expected: []int{5}},
+		{page: 4, pageSize: 2, expected: []int{}},
+		{page: 1, pageSize: 10, expected: []int{1, 2, 3, 4, 5}},
+		{page: int(^uint(0) >> 1), pageSize: 1000, expected: []int{}},
+	}
+	for _, testDef := range testDefs {
+		got, paging := paginate(
+			items,
+			requestPagination{
+				Page:     testDef.page,
+				PageSize: testDef.pageSize,
+			},
+		)
+		if len(got) != len(testDef.expected) {
+			t.Fatalf(
+				"page %d/%d: expected %v, got %v",
+				testDef.page,
+				testDef.pageSize,
+				testDef.expected,
+				got,
+			)
+		}
+		for i := range got {
+			if got[i] != testDef.expected[i] {
+				t.Fatalf(
+					"page %d/%d: expected %v, got %v",
+					testDef.page,
+					testDef.pageSize,
+					testDef.expected,
+					got,
+				)
+			}
+		}
+		if paging.Total != len(items) || paging.Page != testDef.page ||
+			paging.PageSize != testDef.pageSize {
+			t.Fatalf("unexpected paging info: %+v", paging)
+		}
+	}
+}
+
+func TestNewPoolParamsResponse(t *testing.T) {
+	var poolId ledger.PoolId
+	poolId[0] = 0x01
+	rewardAddr, err := ledger.NewAddressFromParts(
+		lcommon.AddressTypeNoneKey,
+		lcommon.AddressNetworkMainnet,
+		nil,
+		make([]byte, lcommon.AddressHashSize),
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	port := uint32(3001)
+	hostname := "relay.example.com"
+	ipv4 := net.IPv4(192, 0, 2, 1)
+	params := &localstatequery.PoolStateParams{
+		Pledge:        100000000000,
+		Cost:          340000000,
+		Margin:        &cbor.Rat{Rat: big.NewRat(1, 50)},
+		RewardAccount: rewardAddr,
+		PoolOwners:    []ledger.Blake2b224{{0xaa}},
+		Relays: []ledger.PoolRelay{
+			{
+				Type:     lcommon.PoolRelayTypeSingleHostName,
+				Port:     &port,
+				Hostname: &hostname,
+			},
+			{
+				Type: lcommon.PoolRelayTypeSingleHostAddress,
+				Port: &port,
+				Ipv4: &ipv4,
+			},
+		},
+	}
+
+	resp := newPoolParamsResponse(poolId, params)
+
+	if resp.PoolId != poolId.String() ||
+		!strings.HasPrefix(resp.PoolId, "pool1") {
+		t.Fatalf("unexpected pool ID: %s", resp.PoolId)
+	}
+	if resp.Pledge != 100000000000 || resp.Cost != 340000000 {
+		t.Fatalf("unexpected pledge/cost: %d/%d", resp.Pledge, resp.Cost)
+	}
+	if resp.Margin.Numerator != 1 || resp.Margin.Denominator != 50 {
+		t.Fatalf("unexpected margin: %+v", resp.Margin)
+	}
+	if !strings.HasPrefix(resp.RewardAccount, "stake1") {
+		t.Fatalf("unexpected reward account: %s", resp.RewardAccount)
+	}
+	if len(resp.Owners) != 1 {
+		t.Fatalf("expected 1 owner, got %d", len(resp.Owners))
+	}
+	if len(resp.Relays) != 2 {
+		t.Fatalf("expected 2 relays, got %d", len(resp.Relays))
+	}
+	if resp.Relays[0].Type != "single_host_name" ||
+		resp.Relays[0].Hostname != hostname ||
+		resp.Relays[0].Port != port {
+		t.Fatalf("unexpected relay: %+v", resp.Relays[0])
+	}
+	if resp.Relays[1].Type != "single_host_address" ||
+		resp.Relays[1].Ipv4 != "192.0.2.1" {
+		t.Fatalf("unexpected relay: %+v", resp.Relays[1])
+	}
+	if resp.Metadata != nil {
+		t.Fatalf("expected no metadata, got %+v", resp.Metadata)
+	}
+	if resp.RetiringEpoch != nil {
+		t.Fatalf("expected no retiring epoch, got %d", *resp.RetiringEpoch)
+	}
+}
+
+func TestHandleLocalStateQueryPoolsValidation(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	configurePoolRoutes(router.Group("/localstatequery"))
+
+	for _, path := range []string{
+		"/localstatequery/pools?page=0",
+		"/localstatequery/pools?page_size=1001",
+		"/localstatequery/stake-distribution?page=abc",
+		"/localstatequery/pools/retiring?page_size=0",
+		"/localstatequery/pools/pool1invalid",
+	} {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+		if w.Code != http.StatusBadRequest {
+			t.Fatalf(
+				"%s: expected status 400, got %d: %s",
+				path,
+				w.Code,
+				w.Body.String(),
+			)
+		}
+	}
+}
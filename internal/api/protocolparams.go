@@ -0,0 +1,364 @@
+// Copyright 2025 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+
+	"github.com/blinklabs-io/gouroboros/cbor"
+	"github.com/blinklabs-io/gouroboros/ledger"
+	lcommon "github.com/blinklabs-io/gouroboros/ledger/common"
+)
+
+type responseLocalStateQueryProtocolParams struct {
+	Era                  string              `json:"era"                             example:"Conway"`
+	ProtocolVersion      protocolVersion     `json:"protocol_version"`
+	MinFeeA              uint                `json:"min_fee_a"                       example:"44"`
+	MinFeeB              uint                `json:"min_fee_b"                       example:"155381"`
+	MaxBlockBodySize     uint                `json:"max_block_body_size"             example:"90112"`
+	MaxTxSize            uint                `json:"max_tx_size"                     example:"16384"`
+	MaxBlockHeaderSize   uint                `json:"max_block_header_size"           example:"1100"`
+	KeyDeposit           uint                `json:"key_deposit"                     example:"2000000"      format:"int64" minimum:"0"`
+	PoolDeposit          uint                `json:"pool_deposit"                    example:"500000000"    format:"int64" minimum:"0"`
+	MaxEpoch             uint                `json:"max_epoch"                       example:"18"`
+	NOpt                 uint                `json:"n_opt"                           example:"500"`
+	PoolPledgeInfluence  rational            `json:"pool_pledge_influence"`
+	MonetaryExpansion    rational            `json:"monetary_expansion"`
+	TreasuryCut          rational            `json:"treasury_cut"`
+	Decentralization     *rational           `json:"decentralization,omitempty"`
+	MinPoolCost          uint64              `json:"min_pool_cost"                   example:"170000000"    format:"int64" minimum:"0"`
+	CoinsPerUtxoByte     uint64              `json:"coins_per_utxo_byte,omitempty"   example:"4310"         format:"int64" minimum:"0"`
+	CoinsPerUtxoWord     uint64              `json:"coins_per_utxo_word,omitempty"                        format:"int64" minimum:"0"`
+	CostModels           map[string][]int64  `json:"cost_models"`
+	ExecutionPrices      executionPrices     `json:"execution_prices"`
+	MaxTxExUnits         exUnits             `json:"max_tx_ex_units"`
+	MaxBlockExUnits      exUnits             `json:"max_block_ex_units"`
+	MaxValueSize         uint                `json:"max_value_size"                  example:"5000"`
+	CollateralPercentage uint                `json:"collateral_percentage"           example:"150"`
+	MaxCollateralInputs  uint                `json:"max_collateral_inputs"           example:"3"`
+	Governance           *governanceParams   `json:"governance,omitempty"`
+	ReferenceScripts     *referenceScriptFee `json:"reference_scripts,omitempty"`
+}
+
+type protocolVersion struct {
+	Major uint `json:"major" example:"10"`
+	Minor uint `json:"minor" example:"0"`
+}
+
+// rational represents an exact rational number from the ledger
+type rational struct {
+	Numerator   int64 `json:"numerator"   example:"577"   format:"int64"`
+	Denominator int64 `json:"denominator" example:"10000" format:"int64"`
+}
+
+type executionPrices struct {
+	Memory rational `json:"memory"`
+	Steps  rational `json:"steps"`
+}
+
+type exUnits struct {
+	Memory int64 `json:"memory" example:"14000000"    format:"int64"`
+	Steps  int64 `json:"steps"  example:"10000000000" format:"int64"`
+}
+
+// governanceParams contains the governance parameters introduced in Conway
+type governanceParams struct {
+	PoolVotingThresholds    poolVotingThresholds `json:"pool_voting_thresholds"`
+	DRepVotingThresholds    drepVotingThresholds `json:"drep_voting_thresholds"`
+	MinCommitteeSize        uint                 `json:"min_committee_size"         example:"7"`
+	CommitteeTermLimit      uint64               `json:"committee_term_limit"       example:"146"`
+	GovActionValidityPeriod uint64               `json:"gov_action_validity_period" example:"6"`
+	GovActionDeposit        uint64               `json:"gov_action_deposit"         example:"100000000000" format:"int64" minimum:"0"`
+	DRepDeposit             uint64               `json:"drep_deposit"               example:"500000000"    format:"int64" minimum:"0"`
+	DRepInactivityPeriod    uint64               `json:"drep_inactivity_period"     example:"20"`
+	CommitteeStakeCoverage  *rational            `json:"committee_stake_coverage,omitempty"`
+	QuorumStakeThreshold    *rational            `json:"quorum_stake_threshold,omitempty"`
+}
+
+type poolVotingThresholds struct {
+	MotionNoConfidence    rational `json:"motion_no_confidence"`
+	CommitteeNormal       rational `json:"committee_normal"`
+	CommitteeNoConfidence rational `json:"committee_no_confidence"`
+	HardForkInitiation    rational `json:"hard_fork_initiation"`
+	PpSecurityGroup       rational `json:"pp_security_group"`
+}
+
+type drepVotingThresholds struct {
+	MotionNoConfidence    rational `json:"motion_no_confidence"`
+	CommitteeNormal       rational `json:"committee_normal"`
+	CommitteeNoConfidence rational `json:"committee_no_confidence"`
+	UpdateToConstitution  rational `json:"update_to_constitution"`
+	HardForkInitiation    rational `json:"hard_fork_initiation"`
+	PpNetworkGroup        rational `json:"pp_network_group"`
+	PpEconomicGroup       rational `json:"pp_economic_group"`
+	PpTechnicalGroup      rational `json:"pp_technical_group"`
+	PpGovGroup            rational `json:"pp_gov_group"`
+	TreasuryWithdrawal    rational `json:"treasury_withdrawal"`
+}
+
+// referenceScriptFee contains the reference script fee parameters introduced
+// in Conway. The size limits and tiering parameters were made protocol
+// parameters in Dijkstra.
+type referenceScriptFee struct {
+	MinFeeCostPerByte rational  `json:"min_fee_cost_per_byte"`
+	MaxSizePerBlock   uint32    `json:"max_size_per_block,omitempty" example:"1048576"`
+	MaxSizePerTx      uint32    `json:"max_size_per_tx,omitempty"    example:"204800"`
+	CostStride        uint32    `json:"cost_stride,omitempty"        example:"25600"`
+	CostMultiplier    *rational `json:"cost_multiplier,omitempty"`
+}
+
+func newRational(r *cbor.Rat) rational {
+	if r == nil || r.Rat == nil {
+		return rational{}
+	}
+	return rational{
+		Numerator:   r.Num().Int64(),
+		Denominator: r.Denom().Int64(),
+	}
+}
+
+// newRationalFromArray converts a rational which was decoded as a generic
+// [numerator, denominator] array
+func newRationalFromArray(val []any) (rational, error) {
+	if len(val) != 2 {
+		return rational{}, fmt.Errorf(
+			"expected 2 elements for rational, got %d",
+			len(val),
+		)
+	}
+	var ret [2]int64
+	for idx, item := range val {
+		switch v := item.(type) {
+		case uint64:
+			if v > math.MaxInt64 {
+				return rational{}, fmt.Errorf(
+					"rational value %d overflows int64",
+					v,
+				)
+			}
+			ret[idx] = int64(v)
+		case int64:
+			ret[idx] = v
+		case *big.Int:
+			if !v.IsInt64() {
+				return rational{}, fmt.Errorf(
+					"rational value %s overflows int64",
+					v,
+				)
+			}
+			ret[idx] = v.Int64()
+		default:
+			return rational{}, fmt.Errorf(
+				"unexpected rational value type %T",
+				item,
+			)
+		}
+	}
+	return rational{
+		Numerator:   ret[0],
+		Denominator: ret[1],
+	}, nil
+}
+
+func newRationalPtr(r *cbor.Rat) *rational {
+	if r == nil || r.Rat == nil {
+		return nil
+	}
+	ret := newRational(r)
+	return &ret
+}
+
+func newExUnits(units lcommon.ExUnits) exUnits {
+	return exUnits{
+		Memory: units.Memory,
+		Steps:  units.Steps,
+	}
+}
+
+func newExecutionPrices(prices lcommon.ExUnitPrice) executionPrices {
+	return executionPrices{
+		Memory: newRational(prices.MemPrice),
+		Steps:  newRational(prices.StepPrice),
+	}
+}
+
+func newCostModels(models map[uint][]int64) map[string][]int64 {
+	ret := make(map[string][]int64, len(models))
+	// Cost models are keyed by Plutus language, which starts at 0 for
+	// PlutusV1
+	for language, model := range models {
+		ret[fmt.Sprintf("PlutusV%d", language+1)] = model
+	}
+	return ret
+}
+
+func newConwayProtocolParams(
+	pp *ledger.ConwayProtocolParameters,
+) responseLocalStateQueryProtocolParams {
+	pvt := pp.PoolVotingThresholds
+	dvt := pp.DRepVotingThresholds
+	return responseLocalStateQueryProtocolParams{
+		Era: "Conway",
+		ProtocolVersion: protocolVersion{
+			Major: pp.ProtocolVersion.Major,
+			Minor: pp.ProtocolVersion.Minor,
+		},
+		MinFeeA:              pp.MinFeeA,
+		MinFeeB:              pp.MinFeeB,
+		MaxBlockBodySize:     pp.MaxBlockBodySize,
+		MaxTxSize:            pp.MaxTxSize,
+		MaxBlockHeaderSize:   pp.MaxBlockHeaderSize,
+		KeyDeposit:           pp.KeyDeposit,
+		PoolDeposit:          pp.PoolDeposit,
+		MaxEpoch:             pp.MaxEpoch,
+		NOpt:                 pp.NOpt,
+		PoolPledgeInfluence:  newRational(pp.A0),
+		MonetaryExpansion:    newRational(pp.Rho),
+		TreasuryCut:          newRational(pp.Tau),
+		MinPoolCost:          pp.MinPoolCost,
+		CoinsPerUtxoByte:     pp.AdaPerUtxoByte,
+		CostModels:           newCostModels(pp.CostModels),
+		ExecutionPrices:      newExecutionPrices(pp.ExecutionCosts),
+		MaxTxExUnits:         newExUnits(pp.MaxTxExUnits),
+		MaxBlockExUnits:      newExUnits(pp.MaxBlockExUnits),
+		MaxValueSize:         pp.MaxValueSize,
+		CollateralPercentage: pp.CollateralPercentage,
+		MaxCollateralInputs:  pp.MaxCollateralInputs,
+		Governance: &governanceParams{
+			PoolVotingThresholds: poolVotingThresholds{
+				MotionNoConfidence:    newRational(&pvt.MotionNoConfidence),
+				CommitteeNormal:       newRational(&pvt.CommitteeNormal),
+				CommitteeNoConfidence: newRational(&pvt.CommitteeNoConfidence),
+				HardForkInitiation:    newRational(&pvt.HardForkInitiation),
+				PpSecurityGroup:       newRational(&pvt.PpSecurityGroup),
+			},
+			DRepVotingThresholds: drepVotingThresholds{
+				MotionNoConfidence:    newRational(&dvt.MotionNoConfidence),
+				CommitteeNormal:       newRational(&dvt.CommitteeNormal),
+				CommitteeNoConfidence: newRational(&dvt.CommitteeNoConfidence),
+				UpdateToConstitution:  newRational(&dvt.UpdateToConstitution),
+				HardForkInitiation:    newRational(&dvt.HardForkInitiation),
+				PpNetworkGroup:        newRational(&dvt.PpNetworkGroup),
+				PpEconomicGroup:       newRational(&dvt.PpEconomicGroup),
+				PpTechnicalGroup:      newRational(&dvt.PpTechnicalGroup),
+				PpGovGroup:            newRational(&dvt.PpGovGroup),
+				TreasuryWithdrawal:    newRational(&dvt.TreasuryWithdrawal),
+			},
+			MinCommitteeSize:        pp.MinCommitteeSize,
+			CommitteeTermLimit:      pp.CommitteeTermLimit,
+			GovActionValidityPeriod: pp.GovActionValidityPeriod,
+			GovActionDeposit:        pp.GovActionDeposit,
+			DRepDeposit:             pp.DRepDeposit,
+			DRepInactivityPeriod:    pp.DRepInactivityPeriod,
+		},
+		ReferenceScripts: &referenceScriptFee{
+			MinFeeCostPerByte: newRational(pp.MinFeeRefScriptCostPerByte),
+		},
+	}
+}
+
+// newProtocolParamsResponse converts the era-specific protocol parameters
+// returned by the node into our stable response model
+func newProtocolParamsResponse(
+	protoParams lcommon.ProtocolParameters,
+) (responseLocalStateQueryProtocolParams, error) {
+	switch pp := protoParams.(type) {
+	case *ledger.DijkstraProtocolParameters:
+		resp := newConwayProtocolParams(&pp.ConwayProtocolParameters)
+		resp.Era = "Dijkstra"
+		resp.Governance.CommitteeStakeCoverage = newRationalPtr(
+			pp.CommitteeStakeCoverage,
+		)
+		resp.Governance.QuorumStakeThreshold = newRationalPtr(
+			pp.QuorumStakeThreshold,
+		)
+		resp.ReferenceScripts.MaxSizePerBlock = pp.MaxRefScriptSizePerBlock
+		resp.ReferenceScripts.MaxSizePerTx = pp.MaxRefScriptSizePerTx
+		resp.ReferenceScripts.CostStride = pp.RefScriptCostStride
+		resp.ReferenceScripts.CostMultiplier = newRationalPtr(
+			pp.RefScriptCostMultiplier,
+		)
+		return resp, nil
+	case *ledger.ConwayProtocolParameters:
+		return newConwayProtocolParams(pp), nil
+	case *ledger.BabbageProtocolParameters:
+		return responseLocalStateQueryProtocolParams{
+			Era: "Babbage",
+			ProtocolVersion: protocolVersion{
+				Major: pp.ProtocolMajor,
+				Minor: pp.ProtocolMinor,
+			},
+			MinFeeA:              pp.MinFeeA,
+			MinFeeB:              pp.MinFeeB,
+			MaxBlockBodySize:     pp.MaxBlockBodySize,
+			MaxTxSize:            pp.MaxTxSize,
+			MaxBlockHeaderSize:   pp.MaxBlockHeaderSize,
+			KeyDeposit:           pp.KeyDeposit,
+			PoolDeposit:          pp.PoolDeposit,
+			MaxEpoch:             pp.MaxEpoch,
+			NOpt:                 pp.NOpt,
+			PoolPledgeInfluence:  newRational(pp.A0),
+			MonetaryExpansion:    newRational(pp.Rho),
+			TreasuryCut:          newRational(pp.Tau),
+			MinPoolCost:          pp.MinPoolCost,
+			CoinsPerUtxoByte:     pp.AdaPerUtxoByte,
+			CostModels:           newCostModels(pp.CostModels),
+			ExecutionPrices:      newExecutionPrices(pp.ExecutionCosts),
+			MaxTxExUnits:         newExUnits(pp.MaxTxExUnits),
+			MaxBlockExUnits:      newExUnits(pp.MaxBlockExUnits),
+			MaxValueSize:         pp.MaxValueSize,
+			CollateralPercentage: pp.CollateralPercentage,
+			MaxCollateralInputs:  pp.MaxCollateralInputs,
+		}, nil
+	case *ledger.AlonzoProtocolParameters:
+		// Alonzo specifies the UTxO cost per 8-byte word rather than per byte
+		return responseLocalStateQueryProtocolParams{
+			Era: "Alonzo",
+			ProtocolVersion: protocolVersion{
+				Major: pp.ProtocolMajor,
+				Minor: pp.ProtocolMinor,
+			},
+			MinFeeA:              pp.MinFeeA,
+			MinFeeB:              pp.MinFeeB,
+			MaxBlockBodySize:     pp.MaxBlockBodySize,
+			MaxTxSize:            pp.MaxTxSize,
+			MaxBlockHeaderSize:   pp.MaxBlockHeaderSize,
+			KeyDeposit:           pp.KeyDeposit,
+			PoolDeposit:          pp.PoolDeposit,
+			MaxEpoch:             pp.MaxEpoch,
+			NOpt:                 pp.NOpt,
+			PoolPledgeInfluence:  newRational(pp.A0),
+			MonetaryExpansion:    newRational(pp.Rho),
+			TreasuryCut:          newRational(pp.Tau),
+			Decentralization:     newRationalPtr(pp.Decentralization),
+			MinPoolCost:          pp.MinPoolCost,
+			CoinsPerUtxoWord:     pp.AdaPerUtxoByte,
+			CostModels:           newCostModels(pp.CostModels),
+			ExecutionPrices:      newExecutionPrices(pp.ExecutionCosts),
+			MaxTxExUnits:         newExUnits(pp.MaxTxExUnits),
+			MaxBlockExUnits:      newExUnits(pp.MaxBlockExUnits),
+			MaxValueSize:         pp.MaxValueSize,
+			CollateralPercentage: pp.CollateralPercentage,
+			MaxCollateralInputs:  pp.MaxCollateralInputs,
+		}, nil
+	default:
+		return responseLocalStateQueryProtocolParams{}, fmt.Errorf(
+			"protocol parameters are not supported for this era: %T",
+			protoParams,
+		)
+	}
+}
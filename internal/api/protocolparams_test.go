@@ -0,0 +1,160 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/blinklabs-io/gouroboros/cbor"
+	"github.com/blinklabs-io/gouroboros/ledger"
+	lcommon "github.com/blinklabs-io/gouroboros/ledger/common"
+)
+
+func testRat(num, denom int64) *cbor.Rat {
+	return &cbor.Rat{Rat: big.NewRat(num, denom)}
+}
+
+func testConwayProtocolParams() ledger.ConwayProtocolParameters {
+	var pp ledger.ConwayProtocolParameters
+	pp.MinFeeA = 44
+	pp.MinFeeB = 155381
+	pp.A0 = testRat(3, 10)
+	pp.ProtocolVersion = lcommon.ProtocolParametersProtocolVersion{
+		Major: 10,
+		Minor: 0,
+	}
+	pp.AdaPerUtxoByte = 4310
+	pp.CostModels = map[uint][]int64{
+		0: {100, 200},
+		2: {300},
+	}
+	pp.ExecutionCosts = lcommon.ExUnitPrice{
+		MemPrice:  testRat(577, 10000),
+		StepPrice: testRat(721, 10000000),
+	}
+	pp.MaxTxExUnits = lcommon.ExUnits{Memory: 14000000, Steps: 10000000000}
+	pp.CollateralPercentage = 150
+	pp.DRepVotingThresholds.TreasuryWithdrawal = cbor.Rat{Rat: big.NewRat(67, 100)}
+	pp.GovActionDeposit = 100000000000
+	pp.MinFeeRefScriptCostPerByte = testRat(15, 1)
+	return pp
+}
+
+func TestNewProtocolParamsResponseConway(t *testing.T) {
+	pp := testConwayProtocolParams()
+
+	resp, err := newProtocolParamsResponse(&pp)
+	if err != nil {
+		t.Fatalf("newProtocolParamsResponse() error = %v", err)
+	}
+	if resp.Era != "Conway" {
+		t.Fatalf("Era = %s, want Conway", resp.Era)
+	}
+	if resp.ProtocolVersion.Major != 10 {
+		t.Fatalf("ProtocolVersion.Major = %d, want 10", resp.ProtocolVersion.Major)
+	}
+	if resp.PoolPledgeInfluence != (rational{Numerator: 3, Denominator: 10}) {
+		t.Fatalf("PoolPledgeInfluence = %+v", resp.PoolPledgeInfluence)
+	}
+	if resp.CoinsPerUtxoByte != 4310 {
+		t.Fatalf("CoinsPerUtxoByte = %d, want 4310", resp.CoinsPerUtxoByte)
+	}
+	if len(resp.CostModels["PlutusV1"]) != 2 || len(resp.CostModels["PlutusV3"]) != 1 {
+		t.Fatalf("unexpected cost models: %v", resp.CostModels)
+	}
+	if resp.ExecutionPrices.Memory != (rational{Numerator: 577, Denominator: 10000}) {
+		t.Fatalf("ExecutionPrices.Memory = %+v", resp.ExecutionPrices.Memory)
+	}
+	if resp.Governance == nil {
+		t.Fatal("expected governance parameters for Conway")
+	}
+	if resp.Governance.DRepVotingThresholds.TreasuryWithdrawal != (rational{Numerator: 67, Denominator: 100}) {
+		t.Fatalf(
+			"TreasuryWithdrawal = %+v",
+			resp.Governance.DRepVotingThresholds.TreasuryWithdrawal,
+		)
+	}
+	if resp.Governance.QuorumStakeThreshold != nil {
+		t.Fatal("expected no Dijkstra-only fields for Conway")
+	}
+	if resp.ReferenceScripts.MinFeeCostPerByte != (rational{Numerator: 15, Denominator: 1}) {
+		t.Fatalf(
+			"MinFeeCostPerByte = %+v",
+			resp.ReferenceScripts.MinFeeCostPerByte,
+		)
+	}
+}
+
+func TestNewProtocolParamsResponseDijkstra(t *testing.T) {
+	pp := ledger.DijkstraProtocolParameters{
+		ConwayProtocolParameters: testConwayProtocolParams(),
+		MaxRefScriptSizePerTx:    204800,
+		RefScriptCostMultiplier:  testRat(6, 5),
+		QuorumStakeThreshold:     testRat(1, 2),
+	}
+
+	resp, err := newProtocolParamsResponse(&pp)
+	if err != nil {
+		t.Fatalf("newProtocolParamsResponse() error = %v", err)
+	}
+	if resp.Era != "Dijkstra" {
+		t.Fatalf("Era = %s, want Dijkstra", resp.Era)
+	}
+	if resp.ReferenceScripts.MaxSizePerTx != 204800 {
+		t.Fatalf("MaxSizePerTx = %d, want 204800", resp.ReferenceScripts.MaxSizePerTx)
+	}
+	if resp.ReferenceScripts.CostMultiplier == nil ||
+		*resp.ReferenceScripts.CostMultiplier != (rational{Numerator: 6, Denominator: 5}) {
+		t.Fatalf("CostMultiplier = %+v", resp.ReferenceScripts.CostMultiplier)
+	}
+	if resp.Governance.QuorumStakeThreshold == nil {
+		t.Fatal("expected quorum stake threshold for Dijkstra")
+	}
+}
+
+func TestNewProtocolParamsResponseAlonzo(t *testing.T) {
+	pp := ledger.AlonzoProtocolParameters{
+		ProtocolMajor:    6,
+		AdaPerUtxoByte:   34482,
+		Decentralization: testRat(0, 1),
+	}
+
+	resp, err := newProtocolParamsResponse(&pp)
+	if err != nil {
+		t.Fatalf("newProtocolParamsResponse() error = %v", err)
+	}
+	if resp.CoinsPerUtxoWord != 34482 || resp.CoinsPerUtxoByte != 0 {
+		t.Fatalf(
+			"CoinsPerUtxoWord = %d, CoinsPerUtxoByte = %d",
+			resp.CoinsPerUtxoWord,
+			resp.CoinsPerUtxoByte,
+		)
+	}
+	if resp.Decentralization == nil {
+		t.Fatal("expected decentralization parameter for Alonzo")
+	}
+	if resp.Governance != nil {
+		t.Fatal("expected no governance parameters for Alonzo")
+	}
+}
+
+func TestNewProtocolParamsResponseUnsupportedEra(t *testing.T) {
+	pp := ledger.ShelleyProtocolParameters{}
+
+	if _, err := newProtocolParamsResponse(&pp); err == nil {
+		t.Fatal("expected error for Shelley protocol parameters")
+	}
+}
@@ -0,0 +1,196 @@
+// Copyright 2025 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"encoding/hex"
+	"net/http"
+
+	"github.com/blinklabs-io/cardano-node-api/internal/node"
+	"github.com/blinklabs-io/gouroboros/ledger"
+	lcommon "github.com/blinklabs-io/gouroboros/ledger/common"
+	"github.com/gin-gonic/gin"
+)
+
+type utxoReferenceScript struct {
+	Type string `json:"type" example:"PlutusV2"`
+	Hash string `json:"hash"`
+	// Cbor contains the raw script bytes, hex encoded
+	Cbor string `json:"cbor"`
+}
+
+type responseLocalStateQueryUTxOs struct {
+	UTxOs []utxoItem `json:"utxos"`
+	Count int        `json:"count"`
+}
+
+func newUtxoItem(utxo ledger.Utxo) utxoItem {
+	output := utxo.Output
+	ret := utxoItem{
+		TxHash:  hex.EncodeToString(utxo.Id.Id().Bytes()),
+		Index:   utxo.Id.Index(),
+		Address: output.Address().String(),
+		Amount:  output.Amount().Uint64(),
+	}
+	if assets := output.Assets(); assets != nil {
+		ret.Assets = assets
+	}
+	if datumHash := output.DatumHash(); datumHash != nil {
+		ret.DatumHash = datumHash.String()
+	}
+	if datum := output.Datum(); datum != nil {
+		ret.Datum = hex.EncodeToString(datum.Cbor())
+	}
+	if script := output.ScriptRef(); script != nil {
+		ret.ReferenceScript = &utxoReferenceScript{
+			Type: scriptTypeName(script),
+			Hash: script.Hash().String(),
+			Cbor: hex.EncodeToString(script.RawScriptBytes()),
+		}
+	}
+	return ret
+}
+
+func newUtxoItems(utxos []ledger.Utxo) []utxoItem {
+	ret := make([]utxoItem, 0, len(utxos))
+	for _, utxo := range utxos {
+		ret = append(ret, newUtxoItem(utxo))
+	}
+	return ret
+}
+
+func scriptTypeName(script lcommon.Script) string {
+	switch script.(type) {
+	case lcommon.NativeScript, *lcommon.NativeScript:
+		return "Native"
+	case lcommon.PlutusV1Script, *lcommon.PlutusV1Script:
+		return "PlutusV1"
+	case lcommon.PlutusV2Script, *lcommon.PlutusV2Script:
+		return "PlutusV2"
+	case lcommon.PlutusV3Script, *lcommon.PlutusV3Script:
+		return "PlutusV3"
+	case lcommon.PlutusV4Script, *lcommon.PlutusV4Script:
+		return "PlutusV4"
+	default:
+		return "Unknown"
+	}
+}
+
+// handleLocalStateQueryUTxOsByAddress godoc
+//
+//	@Summary		Query UTxOs by Address
+//	@Description	Query the UTxOs at one or more addresses. Addresses may be provided in bech32 or hex form.
+//	@Tags			localstatequery
+//	@Produce		json
+//	@Param			address	query		[]string	true	"Address (bech32 or hex), may be repeated"	collectionFormat(multi)
+//	@Success		200		{object}	responseLocalStateQueryUTxOs
+//	@Failure		400		{object}	responseApiError
+//	@Failure		500		{object}	responseApiError
+//	@Router			/localstatequery/utxos/by-address [get]
+func handleLocalStateQueryUTxOsByAddress(c *gin.Context) {
+	// Get parameters
+	addrStrs := c.QueryArray("address")
+	if len(addrStrs) == 0 {
+		c.JSON(http.StatusBadRequest, apiError("address parameter is required"))
+		return
+	}
+	addrs := make([]ledger.Address, 0, len(addrStrs))
+	for _, addrStr := range addrStrs {
+		addr, err := node.ParseAddress(addrStr)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, apiError(err.Error()))
+			return
+		}
+		addrs = append(addrs, addr)
+	}
+
+	// Lease a node connection from the pool
+	lease, err := node.AcquireConnection(c.Request.Context())
+	if err != nil {
+		c.JSON(500, apiError(err.Error()))
+		return
+	}
+	defer lease.Release()
+
+	// Get UTxOs
+	utxos, err := node.UtxosByAddress(lease.LocalStateQuery(), addrs)
+	if err != nil {
+		c.JSON(500, apiError(err.Error()))
+		return
+	}
+
+	// Create response
+	resp := responseLocalStateQueryUTxOs{
+		UTxOs: newUtxoItems(utxos),
+		Count: len(utxos),
+	}
+	c.JSON(200, resp)
+}
+
+type requestLocalStateQueryUTxOsByTxIn struct {
+	TxIns []string `json:"txins" binding:"required,min=1" example:"9f6a2cd3c2a25e1f4a6ec0f8c8ec1e30d4a5e5d0b6a56cb4df0b7a6b2f23e8d1#0"`
+}
+
+// handleLocalStateQueryUTxOsByTxIn godoc
+//
+//	@Summary		Query UTxOs by Transaction Input
+//	@Description	Resolve a batch of transaction inputs, each in the form <tx hash>#<index>. Inputs which are not in the UTxO set are omitted from the response.
+//	@Tags			localstatequery
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body		requestLocalStateQueryUTxOsByTxIn	true	"Transaction inputs"
+//	@Success		200		{object}	responseLocalStateQueryUTxOs
+//	@Failure		400		{object}	responseApiError
+//	@Failure		500		{object}	responseApiError
+//	@Router			/localstatequery/utxos/by-txin [post]
+func handleLocalStateQueryUTxOsByTxIn(c *gin.Context) {
+	// Get parameters
+	var req requestLocalStateQueryUTxOsByTxIn
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, apiError(err.Error()))
+		return
+	}
+	txIns := make([]ledger.TransactionInput, 0, len(req.TxIns))
+	for _, txInStr := range req.TxIns {
+		txIn, err := node.ParseTxIn(txInStr)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, apiError(err.Error()))
+			return
+		}
+		txIns = append(txIns, txIn)
+	}
+
+	// Lease a node connection from the pool
+	lease, err := node.AcquireConnection(c.Request.Context())
+	if err != nil {
+		c.JSON(500, apiError(err.Error()))
+		return
+	}
+	defer lease.Release()
+
+	// Get UTxOs
+	utxos, err := node.UtxosByTxIn(lease.LocalStateQuery(), txIns)
+	if err != nil {
+		c.JSON(500, apiError(err.Error()))
+		return
+	}
+
+	// Create response
+	resp := responseLocalStateQueryUTxOs{
+		UTxOs: newUtxoItems(utxos),
+		Count: len(utxos),
+	}
+	c.JSON(200, resp)
+}
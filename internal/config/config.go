@@ -16,7 +16,10 @@ package config
 
 import (
 	"fmt"
+	"net"
 	"os"
+	"strconv"
+	"strings"
 
 	ouroboros "github.com/blinklabs-io/gouroboros"
 	"github.com/kelseyhightower/envconfig"
@@ -31,6 +34,8 @@ type Config struct {
 	Debug   DebugConfig   `yaml:"debug"`
 	Utxorpc UtxorpcConfig `yaml:"utxorpc"`
 	Node    NodeConfig    `yaml:"node"`
+	Health  HealthConfig  `yaml:"health"`
+	Submit  SubmitConfig  `yaml:"submit"`
 }
 
 type LoggingConfig struct {
@@ -55,19 +60,98 @@ type MetricsConfig struct {
 }
 
 type NodeConfig struct {
-	Network      string `yaml:"network"      envconfig:"CARDANO_NETWORK"`
-	Address      string `yaml:"address"      envconfig:"CARDANO_NODE_SOCKET_TCP_HOST"`
-	SocketPath   string `yaml:"socketPath"   envconfig:"CARDANO_NODE_SOCKET_PATH"`
-	Port         uint   `yaml:"port"         envconfig:"CARDANO_NODE_SOCKET_TCP_PORT"`
-	QueryTimeout uint   `yaml:"queryTimeout" envconfig:"CARDANO_NODE_SOCKET_QUERY_TIMEOUT"`
-	Timeout      uint   `yaml:"timeout"      envconfig:"CARDANO_NODE_SOCKET_TIMEOUT"`
-	NetworkMagic uint32 `yaml:"networkMagic" envconfig:"CARDANO_NODE_NETWORK_MAGIC"`
-	SkipCheck    bool   `yaml:"skipCheck"    envconfig:"CARDANO_NODE_SKIP_CHECK"`
+	Network               string           `yaml:"network"               envconfig:"CARDANO_NETWORK"`
+	Address               string           `yaml:"address"               envconfig:"CARDANO_NODE_SOCKET_TCP_HOST"`
+	SocketPath            string           `yaml:"socketPath"            envconfig:"CARDANO_NODE_SOCKET_PATH"`
+	Port                  uint             `yaml:"port"                  envconfig:"CARDANO_NODE_SOCKET_TCP_PORT"`
+	QueryTimeout          uint             `yaml:"queryTimeout"          envconfig:"CARDANO_NODE_SOCKET_QUERY_TIMEOUT"`
+	Timeout               uint             `yaml:"timeout"               envconfig:"CARDANO_NODE_SOCKET_TIMEOUT"`
+	NetworkMagic          uint32           `yaml:"networkMagic"          envconfig:"CARDANO_NODE_NETWORK_MAGIC"`
+	SkipCheck             bool             `yaml:"skipCheck"             envconfig:"CARDANO_NODE_SKIP_CHECK"`
+	PoolSize              uint             `yaml:"poolSize"              envconfig:"CARDANO_NODE_POOL_SIZE"`
+	PoolWaitTimeout       uint             `yaml:"poolWaitTimeout"       envconfig:"CARDANO_NODE_POOL_WAIT_TIMEOUT"`
+	Upstreams             []UpstreamConfig `yaml:"upstreams"             envconfig:"CARDANO_NODE_UPSTREAMS"`
+	UpstreamCheckInterval uint             `yaml:"upstreamCheckInterval" envconfig:"CARDANO_NODE_UPSTREAM_CHECK_INTERVAL"`
+	UpstreamMaxSlotLag    uint64           `yaml:"upstreamMaxSlotLag"    envconfig:"CARDANO_NODE_UPSTREAM_MAX_SLOT_LAG"`
+}
+
+// UpstreamConfig describes a single cardano-node to connect to. Either the
+// socket path or the address/port should be set.
+type UpstreamConfig struct {
+	Name       string `yaml:"name"`
+	Address    string `yaml:"address"`
+	Port       uint   `yaml:"port"`
+	SocketPath string `yaml:"socketPath"`
+}
+
+// Decode parses an upstream from an environment variable value. Each value is
+// either a UNIX socket path or a host:port pair, optionally prefixed with a
+// name and '=' (e.g. "relay1=/ipc/relay1.socket").
+func (u *UpstreamConfig) Decode(value string) error {
+	name, target, found := strings.Cut(value, "=")
+	if !found {
+		target = name
+		name = ""
+	}
+	u.Name = name
+	if strings.HasPrefix(target, "/") {
+		u.SocketPath = target
+		return nil
+	}
+	host, portStr, err := net.SplitHostPort(target)
+	if err != nil {
+		return fmt.Errorf("invalid upstream %q: %w", value, err)
+	}
+	port, err := strconv.ParseUint(portStr, 10, 16)
+	if err != nil {
+		return fmt.Errorf("invalid upstream port %q: %w", value, err)
+	}
+	u.Address = host
+	u.Port = uint(port)
+	return nil
+}
+
+// UpstreamList returns the configured upstreams, falling back to the single
+// node address/port or socket path when no upstreams are configured
+func (c NodeConfig) UpstreamList() []UpstreamConfig {
+	var ret []UpstreamConfig
+	if len(c.Upstreams) > 0 {
+		ret = append(ret, c.Upstreams...)
+	} else if c.Address != "" && c.Port > 0 {
+		ret = []UpstreamConfig{{Address: c.Address, Port: c.Port}}
+	} else if c.SocketPath != "" {
+		ret = []UpstreamConfig{{SocketPath: c.SocketPath}}
+	}
+	for idx := range ret {
+		if ret[idx].Name == "" {
+			ret[idx].Name = ret[idx].String()
+		}
+	}
+	return ret
+}
+
+// String returns the address used to reach the upstream
+func (u UpstreamConfig) String() string {
+	if u.Address != "" && u.Port > 0 {
+		return net.JoinHostPort(u.Address, strconv.FormatUint(uint64(u.Port), 10))
+	}
+	return u.SocketPath
+}
+
+type HealthConfig struct {
+	ReadyMaxTipAge uint `yaml:"readyMaxTipAge" envconfig:"HEALTH_READY_MAX_TIP_AGE"`
+	LiveMaxTipAge  uint `yaml:"liveMaxTipAge"  envconfig:"HEALTH_LIVE_MAX_TIP_AGE"`
+	CacheTime      uint `yaml:"cacheTime"      envconfig:"HEALTH_CACHE_TIME"`
 }
 
 type UtxorpcConfig struct {
-	ListenAddress string `yaml:"address" envconfig:"GRPC_LISTEN_ADDRESS"`
-	ListenPort    uint   `yaml:"port"    envconfig:"GRPC_LISTEN_PORT"`
+	ListenAddress        string `yaml:"address"              envconfig:"GRPC_LISTEN_ADDRESS"`
+	ListenPort           uint   `yaml:"port"                 envconfig:"GRPC_LISTEN_PORT"`
+	MaxUtxoSearchResults int    `yaml:"maxUtxoSearchResults" envconfig:"GRPC_MAX_UTXO_SEARCH_RESULTS"`
+}
+
+type SubmitConfig struct {
+	Validate bool `yaml:"validate" envconfig:"SUBMIT_VALIDATE"`
 }
 
 type TlsConfig struct {
@@ -95,14 +179,24 @@ var globalConfig = &Config{
 		ListenPort:    8081,
 	},
 	Node: NodeConfig{
-		Network:      "mainnet",
-		SocketPath:   "/node-ipc/node.socket",
-		QueryTimeout: 180,
-		Timeout:      5,
+		Network:               "mainnet",
+		SocketPath:            "/node-ipc/node.socket",
+		QueryTimeout:          180,
+		Timeout:               5,
+		PoolSize:              4,
+		PoolWaitTimeout:       30,
+		UpstreamCheckInterval: 10,
+		UpstreamMaxSlotLag:    120,
 	},
 	Utxorpc: UtxorpcConfig{
-		ListenAddress: "",
-		ListenPort:    9090,
+		ListenAddress:        "",
+		ListenPort:           9090,
+		MaxUtxoSearchResults: 0,
+	},
+	Health: HealthConfig{
+		ReadyMaxTipAge: 300,
+		LiveMaxTipAge:  0,
+		CacheTime:      5,
 	},
 }
 
@@ -136,6 +230,14 @@ func Load(configFile string) (*Config, error) {
 		}
 		globalConfig.Node.NetworkMagic = network.NetworkMagic
 	}
+	for idx, upstream := range globalConfig.Node.Upstreams {
+		if upstream.String() == "" {
+			return nil, fmt.Errorf(
+				"upstream %d: you must specify either the UNIX socket path or the address/port",
+				idx,
+			)
+		}
+	}
 	return globalConfig, nil
 }
 
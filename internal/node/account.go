@@ -0,0 +1,134 @@
+// Copyright 2025 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"fmt"
+
+	"github.com/blinklabs-io/gouroboros/ledger"
+	lcommon "github.com/blinklabs-io/gouroboros/ledger/common"
+	"github.com/blinklabs-io/gouroboros/protocol/localstatequery"
+)
+
+// Account is the delegation and reward state of a stake address
+type Account struct {
+	StakeAddress ledger.Address
+	// Registered indicates whether the stake credential is registered. The
+	// other fields are only populated for registered credentials.
+	Registered    bool
+	RewardBalance uint64
+	Pool          *ledger.PoolId
+	// Drep is only populated in the Conway era and later
+	Drep *lcommon.Drep
+}
+
+// ParseStakeAddress parses a bech32 stake address (stake1... or
+// stake_test1...)
+func ParseStakeAddress(stakeAddress string) (ledger.Address, error) {
+	addr, err := ledger.NewAddress(stakeAddress)
+	if err != nil {
+		return ledger.Address{}, fmt.Errorf(
+			"invalid stake address %q: %w",
+			stakeAddress,
+			err,
+		)
+	}
+	switch addr.Type() {
+	case lcommon.AddressTypeNoneKey, lcommon.AddressTypeNoneScript:
+	default:
+		return ledger.Address{}, fmt.Errorf(
+			"invalid stake address %q: not a reward address",
+			stakeAddress,
+		)
+	}
+	return addr, nil
+}
+
+func stakeCredentialFromAddress(
+	addr ledger.Address,
+) (localstatequery.StakeCredential, error) {
+	cred, ok := addr.StakeCredential()
+	if !ok {
+		return localstatequery.StakeCredential{}, fmt.Errorf(
+			"address %s has no stake credential",
+			addr.String(),
+		)
+	}
+	return localstatequery.StakeCredential{
+		Tag:   uint64(cred.CredType),
+		Bytes: cred.Credential,
+	}, nil
+}
+
+// QueryAccounts returns the delegation and reward state for the provided stake
+// addresses, in the order they were provided
+func QueryAccounts(
+	client *localstatequery.Client,
+	stakeAddresses []ledger.Address,
+) ([]Account, error) {
+	creds := make([]localstatequery.StakeCredential, 0, len(stakeAddresses))
+	voteCreds := make([]lcommon.Credential, 0, len(stakeAddresses))
+	for _, addr := range stakeAddresses {
+		cred, err := stakeCredentialFromAddress(addr)
+		if err != nil {
+			return nil, err
+		}
+		creds = append(creds, cred)
+		voteCreds = append(
+			voteCreds,
+			lcommon.Credential{
+				CredType:   uint(cred.Tag),
+				Credential: cred.Bytes,
+			},
+		)
+	}
+	delegations, err := client.GetFilteredDelegationsAndRewardAccounts(creds)
+	if err != nil {
+		return nil, err
+	}
+	// DRep delegations only exist from Conway onward
+	var voteDelegatees localstatequery.FilteredVoteDelegateesResult
+	currentEra, err := client.GetCurrentEra()
+	if err != nil {
+		return nil, err
+	}
+	if currentEra >= ledger.EraIdConway {
+		tmpVoteDelegatees, err := client.GetFilteredVoteDelegatees(voteCreds)
+		if err != nil {
+			return nil, err
+		}
+		voteDelegatees = *tmpVoteDelegatees
+	}
+	ret := make([]Account, 0, len(stakeAddresses))
+	for idx, addr := range stakeAddresses {
+		cred := creds[idx]
+		account := Account{
+			StakeAddress: addr,
+		}
+		if reward, ok := delegations.Rewards[cred]; ok {
+			account.Registered = true
+			account.RewardBalance = reward
+		}
+		if pool, ok := delegations.Delegations[cred]; ok {
+			poolId := ledger.PoolId(pool)
+			account.Pool = &poolId
+		}
+		if drep, ok := voteDelegatees[cred]; ok {
+			account.Drep = &drep
+		}
+		ret = append(ret, account)
+	}
+	return ret, nil
+}
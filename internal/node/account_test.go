@@ -0,0 +1,91 @@
+// Copyright 2025 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"testing"
+
+	"github.com/blinklabs-io/gouroboros/ledger"
+	lcommon "github.com/blinklabs-io/gouroboros/ledger/common"
+)
+
+func TestParseStakeAddress(t *testing.T) {
+	stakeHash := make([]byte, lcommon.AddressHashSize)
+	stakeHash[0] = 0x42
+	testDefs := []struct {
+		addrType uint8
+		payment  []byte
+		stake    []byte
+		credType uint64
+		wantErr  bool
+	}{
+		{
+			addrType: lcommon.AddressTypeNoneKey,
+			stake:    stakeHash,
+			credType: lcommon.CredentialTypeAddrKeyHash,
+		},
+		{
+			addrType: lcommon.AddressTypeNoneScript,
+			stake:    stakeHash,
+			credType: lcommon.CredentialTypeScriptHash,
+		},
+		{
+			// Base addresses carry a stake credential but are not reward
+			// addresses
+			addrType: lcommon.AddressTypeKeyKey,
+			payment:  make([]byte, lcommon.AddressHashSize),
+			stake:    stakeHash,
+			wantErr:  true,
+		},
+	}
+	for _, testDef := range testDefs {
+		addr, err := ledger.NewAddressFromParts(
+			testDef.addrType,
+			lcommon.AddressNetworkMainnet,
+			testDef.payment,
+			testDef.stake,
+		)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		got, err := ParseStakeAddress(addr.String())
+		if testDef.wantErr {
+			if err == nil {
+				t.Errorf("expected error for address %s", addr.String())
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		cred, err := stakeCredentialFromAddress(got)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if cred.Tag != testDef.credType {
+			t.Errorf(
+				"unexpected credential type: got %d, expected %d",
+				cred.Tag,
+				testDef.credType,
+			)
+		}
+		if cred.Bytes != ledger.NewBlake2b224(stakeHash) {
+			t.Errorf("unexpected credential hash: %s", cred.Bytes.String())
+		}
+	}
+	if _, err := ParseStakeAddress("stake1invalid"); err == nil {
+		t.Error("expected error for invalid stake address")
+	}
+}
@@ -0,0 +1,356 @@
+// Copyright 2025 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/blinklabs-io/adder/event"
+	ouroboros "github.com/blinklabs-io/gouroboros"
+	"github.com/blinklabs-io/gouroboros/ledger"
+	"github.com/blinklabs-io/gouroboros/protocol/chainsync"
+	ocommon "github.com/blinklabs-io/gouroboros/protocol/common"
+)
+
+const (
+	// Number of recently seen block points kept as starting points for
+	// fetching blocks
+	blockPointIndexSize = 4320
+	// Maximum number of known points offered to the node when intersecting
+	// before the requested blocks
+	blockFetchIntersectPoints = 20
+	// Maximum number of blocks read while looking for the requested blocks
+	blockFetchScanLimit = 2160
+)
+
+var (
+	ErrBlockNotFound    = errors.New("block not found")
+	ErrBlockUnreachable = errors.New("block not reachable from known points")
+)
+
+// blockPointIndex records the points of blocks followed from the tip by the
+// chain indexer, so that later block fetches can intersect the chain just
+// before the block they're looking for
+type blockPointIndex struct {
+	mu     sync.Mutex
+	size   int
+	points []ocommon.Point
+}
+
+var globalBlockPointIndex = newBlockPointIndex(blockPointIndexSize)
+
+func newBlockPointIndex(size int) *blockPointIndex {
+	return &blockPointIndex{
+		size: size,
+	}
+}
+
+func (i *blockPointIndex) add(point ocommon.Point) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.points = append(i.points, point)
+	if len(i.points) > i.size {
+		i.points = slices.Delete(i.points, 0, len(i.points)-i.size)
+	}
+}
+
+// before returns up to limit of the most recently recorded points with a
+// slot before the provided slot, newest first. Points from abandoned forks
+// may be included, which is fine for use as intersect points.
+func (i *blockPointIndex) before(slot uint64, limit int) []ocommon.Point {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	ret := make([]ocommon.Point, 0, limit)
+	for idx := len(i.points) - 1; idx >= 0 && len(ret) < limit; idx-- {
+		if i.points[idx].Slot < slot {
+			ret = append(ret, i.points[idx])
+		}
+	}
+	return ret
+}
+
+// chainScanner reads blocks from a dedicated chain-sync connection
+type chainScanner struct {
+	oConn    *ouroboros.Connection
+	connChan chan event.Event
+	// The chain tip when the scanner was created, which bounds any scan
+	tip ocommon.Point
+}
+
+func newChainScanner() (*chainScanner, error) {
+	connChan := make(chan event.Event, 10)
+	oConn, err := GetConnection(&ConnectionConfig{
+		ChainSyncEventChan: connChan,
+	})
+	if err != nil {
+		return nil, err
+	}
+	tip, err := oConn.ChainSync().Client.GetCurrentTip()
+	if err != nil {
+		closeConnection(oConn, connChan)
+		return nil, fmt.Errorf("failed to get tip: %w", err)
+	}
+	return &chainScanner{
+		oConn:    oConn,
+		connChan: connChan,
+		tip:      tip.Point,
+	}, nil
+}
+
+func (s *chainScanner) Close() {
+	closeConnection(s.oConn, s.connChan)
+}
+
+// intersectPointsBefore returns the known points to intersect at in order to
+// read the chain from the provided slot onward. The origin is only included
+// when the slot is close enough to it to be reached by a bounded scan, as
+// there's at most one block per slot.
+func (s *chainScanner) intersectPointsBefore(slot uint64) []ocommon.Point {
+	ret := globalBlockPointIndex.before(slot, blockFetchIntersectPoints)
+	if slot < blockFetchScanLimit {
+		ret = append(ret, ocommon.NewPointOrigin())
+	}
+	return ret
+}
+
+// scan reads the chain forward from the best of the provided intersect
+// points, passing each block to fn until it returns false or the block at the
+// tip has been read. The intersect points must be before the tip, as the scan
+// would otherwise wait for a new block.
+func (s *chainScanner) scan(
+	ctx context.Context,
+	intersectPoints []ocommon.Point,
+	fn func(ledger.Block) bool,
+) error {
+	if err := s.oConn.ChainSync().Client.Sync(intersectPoints); err != nil {
+		return err
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case err, ok := <-s.oConn.ErrorChan():
+			if !ok {
+				err = errors.New("connection closed")
+			}
+			return err
+		case evt := <-s.connChan:
+			payload, ok := evt.Payload.(event.BlockEvent)
+			if !ok || payload.Block == nil {
+				continue
+			}
+			if !fn(payload.Block) ||
+				payload.Block.SlotNumber() >= s.tip.Slot {
+				return nil
+			}
+		}
+	}
+}
+
+func blockMatchesPoint(block ledger.Block, point ocommon.Point) bool {
+	return block.SlotNumber() == point.Slot &&
+		bytes.Equal(block.Hash().Bytes(), point.Hash)
+}
+
+// FetchBlocks returns the blocks at the provided points, in the order
+// requested. Node-to-client chain-sync only delivers the blocks following an
+// intersection, so the chain is read forward from the closest earlier point
+// seen by this process, or from the origin. Blocks which aren't on the chain
+// are reported as ErrBlockNotFound, and blocks which are on the chain but
+// can't be reached within a bounded number of blocks from those points are
+// reported as ErrBlockUnreachable.
+func FetchBlocks(
+	ctx context.Context,
+	points []ocommon.Point,
+) ([]ledger.Block, error) {
+	if len(points) == 0 {
+		return nil, nil
+	}
+	scanner, err := newChainScanner()
+	if err != nil {
+		return nil, err
+	}
+	defer scanner.Close()
+	var minIdx int
+	maxSlot := points[0].Slot
+	for idx, point := range points {
+		if point.Slot > scanner.tip.Slot {
+			return nil, blockNotFoundError(point)
+		}
+		if point.Slot < points[minIdx].Slot {
+			minIdx = idx
+		}
+		maxSlot = max(maxSlot, point.Slot)
+	}
+	intersectPoints := scanner.intersectPointsBefore(points[minIdx].Slot)
+	if len(intersectPoints) == 0 {
+		return nil, missingBlockError(ctx, points[minIdx])
+	}
+	blocks := make([]ledger.Block, len(points))
+	remaining := len(points)
+	var scanned int
+	// The slot of the last block read, which shows how far the scan got
+	var lastSlot uint64
+	err = scanner.scan(
+		ctx,
+		intersectPoints,
+		func(block ledger.Block) bool {
+			scanned++
+			lastSlot = block.SlotNumber()
+			for idx, point := range points {
+				if blocks[idx] == nil && blockMatchesPoint(block, point) {
+					blocks[idx] = block
+					remaining--
+				}
+			}
+			return remaining > 0 && scanned < blockFetchScanLimit &&
+				block.SlotNumber() < maxSlot
+		},
+	)
+	if err != nil {
+		if errors.Is(err, chainsync.ErrIntersectNotFound) {
+			return nil, missingBlockError(ctx, points[minIdx])
+		}
+		return nil, err
+	}
+	for idx, block := range blocks {
+		if block != nil {
+			continue
+		}
+		if scanned == 0 || lastSlot < points[idx].Slot {
+			return nil, missingBlockError(ctx, points[idx])
+		}
+		return nil, blockNotFoundError(points[idx])
+	}
+	return blocks, nil
+}
+
+// ReadBlocks returns up to count consecutive blocks following the block at
+// the provided point, or starting with the first block of the chain if no
+// point is provided. The chain is read by intersecting at the point itself,
+// so any block on the chain can be used without a record of earlier points.
+// Fewer blocks are returned when the chain tip is reached, and points which
+// aren't on the chain are reported as ErrBlockNotFound.
+func ReadBlocks(
+	ctx context.Context,
+	start *ocommon.Point,
+	count int,
+) ([]ledger.Block, error) {
+	if count < 1 {
+		return nil, nil
+	}
+	scanner, err := newChainScanner()
+	if err != nil {
+		return nil, err
+	}
+	defer scanner.Close()
+	intersectPoints := []ocommon.Point{ocommon.NewPointOrigin()}
+	if start != nil {
+		if start.Slot >= scanner.tip.Slot {
+			// There are no blocks after the tip
+			if start.Slot == scanner.tip.Slot &&
+				bytes.Equal(start.Hash, scanner.tip.Hash) {
+				return nil, nil
+			}
+			return nil, blockNotFoundError(*start)
+		}
+		intersectPoints = []ocommon.Point{*start}
+	} else if scanner.tip.Slot == 0 && len(scanner.tip.Hash) == 0 {
+		// Empty chain
+		return nil, nil
+	}
+	ret := make([]ledger.Block, 0, count)
+	err = scanner.scan(
+		ctx,
+		intersectPoints,
+		func(block ledger.Block) bool {
+			ret = append(ret, block)
+			return len(ret) < count
+		},
+	)
+	if err != nil {
+		if start != nil && errors.Is(err, chainsync.ErrIntersectNotFound) {
+			return nil, blockNotFoundError(*start)
+		}
+		return nil, err
+	}
+	return ret, nil
+}
+
+// missingBlockError returns the error for a block which the scan didn't
+// reach. The block can't be read by intersecting at its own point, as that
+// only delivers the blocks after it, but doing so tells whether it's on the
+// chain at all.
+func missingBlockError(ctx context.Context, point ocommon.Point) error {
+	onChain, err := blockOnChain(ctx, point)
+	if err != nil {
+		return fmt.Errorf("failed to check for block on chain: %w", err)
+	}
+	if !onChain {
+		return blockNotFoundError(point)
+	}
+	return blockUnreachableError(point)
+}
+
+// blockOnChain reports whether the block at the provided point is on the
+// chain, by intersecting a dedicated chain-sync connection at the point
+func blockOnChain(ctx context.Context, point ocommon.Point) (bool, error) {
+	oConn, err := GetConnection(nil)
+	if err != nil {
+		return false, err
+	}
+	// Closing the connection also stops a request which is still waiting on
+	// the node
+	context.AfterFunc(ctx, func() {
+		oConn.Close()
+	})
+	defer oConn.Close()
+	_, _, err = oConn.ChainSync().Client.GetAvailableBlockRange(
+		[]ocommon.Point{point},
+	)
+	if err != nil {
+		if errors.Is(err, chainsync.ErrIntersectNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+func blockNotFoundError(point ocommon.Point) error {
+	return fmt.Errorf(
+		"%w: slot %d, hash %x",
+		ErrBlockNotFound,
+		point.Slot,
+		point.Hash,
+	)
+}
+
+func blockUnreachableError(point ocommon.Point) error {
+	return fmt.Errorf(
+		"%w: slot %d, hash %x: only blocks within %d blocks after a block "+
+			"seen since startup, or after the start of the chain, can be "+
+			"fetched",
+		ErrBlockUnreachable,
+		point.Slot,
+		point.Hash,
+		blockFetchScanLimit,
+	)
+}
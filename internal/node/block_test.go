@@ -0,0 +1,72 @@
+// Copyright 2025 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"testing"
+
+	ocommon "github.com/blinklabs-io/gouroboros/protocol/common"
+)
+
+func TestBlockPointIndex(t *testing.T) {
+	index := newBlockPointIndex(3)
+	for slot := uint64(1); slot <= 5; slot++ {
+		index.add(ocommon.NewPoint(slot*10, []byte{byte(slot)}))
+	}
+
+	// The oldest points are dropped once the index is full
+	got := index.before(100, 10)
+	if len(got) != 3 || got[0].Slot != 50 || got[2].Slot != 30 {
+		t.Fatalf("unexpected points: %+v", got)
+	}
+
+	got = index.before(45, 10)
+	if len(got) != 2 || got[0].Slot != 40 || got[1].Slot != 30 {
+		t.Fatalf("unexpected points: %+v", got)
+	}
+
+	got = index.before(100, 1)
+	if len(got) != 1 || got[0].Slot != 50 {
+		t.Fatalf("unexpected points: %+v", got)
+	}
+
+	if got := index.before(30, 10); len(got) != 0 {
+		t.Fatalf("unexpected points: %+v", got)
+	}
+}
+
+func TestIntersectPointsBefore(t *testing.T) {
+	origIndex := globalBlockPointIndex
+	defer func() {
+		globalBlockPointIndex = origIndex
+	}()
+	globalBlockPointIndex = newBlockPointIndex(10)
+	scanner := &chainScanner{}
+
+	// The origin is only offered for slots a bounded scan can reach
+	got := scanner.intersectPointsBefore(blockFetchScanLimit - 1)
+	if len(got) != 1 || got[0].Slot != 0 || len(got[0].Hash) != 0 {
+		t.Fatalf("unexpected points: %+v", got)
+	}
+	if got := scanner.intersectPointsBefore(100000); len(got) != 0 {
+		t.Fatalf("unexpected points: %+v", got)
+	}
+
+	globalBlockPointIndex.add(ocommon.NewPoint(90000, []byte{0x01}))
+	got = scanner.intersectPointsBefore(100000)
+	if len(got) != 1 || got[0].Slot != 90000 {
+		t.Fatalf("unexpected points: %+v", got)
+	}
+}
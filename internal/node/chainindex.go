@@ -0,0 +1,88 @@
+// Copyright 2025 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"context"
+	"time"
+
+	"github.com/blinklabs-io/adder/event"
+	"github.com/blinklabs-io/cardano-node-api/internal/logging"
+	ocommon "github.com/blinklabs-io/gouroboros/protocol/common"
+)
+
+// StartChainIndexer follows the chain from the tip in the background, so
+// that the block point and transaction indexes are kept up to date even when
+// no client is following the chain. It's the only writer of those indexes,
+// so that streams replaying older parts of the chain don't evict recent
+// entries or add blocks which are never rolled back. The follower is
+// restarted from the tip whenever it stops.
+func StartChainIndexer(ctx context.Context) {
+	go runChainIndexer(ctx)
+}
+
+func runChainIndexer(ctx context.Context) {
+	logger := logging.GetLogger().With("component", "chainindex")
+	backoff := followerMinBackoff
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(backoff):
+			}
+			backoff = min(backoff*2, followerMaxBackoff)
+		}
+		follower := NewChainFollower(nil)
+		if err := follower.Start(ctx); err != nil {
+			logger.Warn("failed to start chain indexer:", "error", err)
+			continue
+		}
+		backoff = followerMinBackoff
+		for evt := range follower.EventChan() {
+			handleChainIndexEvent(globalTxIndex, globalBlockPointIndex, evt)
+		}
+		follower.Stop()
+		if ctx.Err() != nil {
+			return
+		}
+		logger.Warn("chain indexer stopped, restarting from the tip")
+	}
+}
+
+// handleChainIndexEvent applies a chain-sync event from the tip to the
+// transaction and block point indexes. Points are kept on rollback, as points
+// from abandoned forks still work as intersect points.
+func handleChainIndexEvent(
+	txs *txIndex,
+	points *blockPointIndex,
+	evt event.Event,
+) {
+	switch payload := evt.Payload.(type) {
+	case event.BlockEvent:
+		if payload.Block == nil {
+			return
+		}
+		points.add(
+			ocommon.NewPoint(
+				payload.Block.SlotNumber(),
+				payload.Block.Hash().Bytes(),
+			),
+		)
+		txs.addBlock(payload.Block)
+	case event.RollbackEvent:
+		txs.rollback(payload.SlotNumber)
+	}
+}
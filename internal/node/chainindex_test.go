@@ -0,0 +1,77 @@
+// Copyright 2025 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"testing"
+	"time"
+
+	"github.com/blinklabs-io/adder/event"
+	"github.com/blinklabs-io/gouroboros/ledger"
+	"github.com/blinklabs-io/gouroboros/ledger/babbage"
+	"github.com/blinklabs-io/gouroboros/ledger/conway"
+	ocommon "github.com/blinklabs-io/gouroboros/protocol/common"
+)
+
+func TestHandleChainIndexEventRollback(t *testing.T) {
+	index := newTxIndex(10, 10)
+	txs := []ledger.Transaction{
+		testIndexTx(t, 0, 1),
+		testIndexTx(t, 1, 2),
+	}
+	index.add(ocommon.NewPoint(10, []byte{0x01}), 5, txs[:1])
+	index.add(ocommon.NewPoint(20, []byte{0x02}), 6, txs[1:])
+
+	handleChainIndexEvent(
+		index,
+		newBlockPointIndex(10),
+		testTrackerRollbackEvent(15),
+	)
+	if _, ok := index.tx(txs[0].Hash()); !ok {
+		t.Fatalf("missing indexed transaction: %s", txs[0].Hash())
+	}
+	if _, ok := index.tx(txs[1].Hash()); ok {
+		t.Fatalf("unexpected indexed transaction: %s", txs[1].Hash())
+	}
+}
+
+func TestHandleChainIndexEventBlock(t *testing.T) {
+	txs := newTxIndex(10, 10)
+	points := newBlockPointIndex(10)
+	block := &conway.ConwayBlock{
+		BlockHeader: &conway.ConwayBlockHeader{
+			BabbageBlockHeader: babbage.BabbageBlockHeader{
+				Body: babbage.BabbageBlockHeaderBody{
+					BlockNumber: 5,
+					Slot:        100,
+				},
+			},
+		},
+	}
+	handleChainIndexEvent(
+		txs,
+		points,
+		event.New(
+			"chainsync.block",
+			time.Now(),
+			nil,
+			event.BlockEvent{Block: block},
+		),
+	)
+	before := points.before(101, 10)
+	if len(before) != 1 || before[0].Slot != 100 {
+		t.Fatalf("did not get expected block points: %v", before)
+	}
+}
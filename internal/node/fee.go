@@ -0,0 +1,177 @@
+// Copyright 2025 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/blinklabs-io/gouroboros/cbor"
+	"github.com/blinklabs-io/gouroboros/ledger"
+	lcommon "github.com/blinklabs-io/gouroboros/ledger/common"
+)
+
+const (
+	// Size of each reference script fee tier, in bytes
+	refScriptFeeTierSize = 25600
+)
+
+// Price multiplier applied to each successive reference script fee tier
+var refScriptFeeTierMultiplier = big.NewRat(6, 5)
+
+// FeeParams are the protocol parameters which determine the minimum fee of
+// a transaction
+type FeeParams struct {
+	minFeeA                    uint
+	minFeeB                    uint
+	executionCosts             lcommon.ExUnitPrice
+	minFeeRefScriptCostPerByte *cbor.Rat
+}
+
+// FeeParamsFromProtocolParams returns the fee params from the current
+// protocol params, which must be from Alonzo or a later era
+func FeeParamsFromProtocolParams(
+	protoParams lcommon.ProtocolParameters,
+) (FeeParams, error) {
+	switch params := protoParams.(type) {
+	case *ledger.DijkstraProtocolParameters:
+		return FeeParams{
+			minFeeA:                    params.MinFeeA,
+			minFeeB:                    params.MinFeeB,
+			executionCosts:             params.ExecutionCosts,
+			minFeeRefScriptCostPerByte: params.MinFeeRefScriptCostPerByte,
+		}, nil
+	case *ledger.ConwayProtocolParameters:
+		return FeeParams{
+			minFeeA:                    params.MinFeeA,
+			minFeeB:                    params.MinFeeB,
+			executionCosts:             params.ExecutionCosts,
+			minFeeRefScriptCostPerByte: params.MinFeeRefScriptCostPerByte,
+		}, nil
+	case *ledger.BabbageProtocolParameters:
+		return FeeParams{
+			minFeeA:        params.MinFeeA,
+			minFeeB:        params.MinFeeB,
+			executionCosts: params.ExecutionCosts,
+		}, nil
+	case *ledger.AlonzoProtocolParameters:
+		return FeeParams{
+			minFeeA:        params.MinFeeA,
+			minFeeB:        params.MinFeeB,
+			executionCosts: params.ExecutionCosts,
+		}, nil
+	default:
+		return FeeParams{}, fmt.Errorf(
+			"unsupported protocol parameters type for fees: %T",
+			protoParams,
+		)
+	}
+}
+
+// MinFee returns the minimum fee of a transaction which uses the provided
+// execution units and spends or references outputs with reference scripts of
+// the provided total size. The transaction size is taken from its current
+// encoding, so a fee computed before the evaluated execution units are set
+// on the redeemers may be off by the few bytes their encoding changes.
+func (p FeeParams) MinFee(
+	tx ledger.Transaction,
+	exUnits lcommon.ExUnits,
+	refScriptSize int,
+) (*big.Int, error) {
+	txSize, err := lcommon.TxSizeForFee(tx)
+	if err != nil {
+		return nil, err
+	}
+	sizeFee, err := lcommon.CalculateMinFee(txSize, p.minFeeA, p.minFeeB)
+	if err != nil {
+		return nil, err
+	}
+	scriptFee, err := p.scriptFee(exUnits)
+	if err != nil {
+		return nil, err
+	}
+	ret := new(big.Int).SetUint64(sizeFee)
+	ret.Add(ret, scriptFee)
+	ret.Add(ret, p.refScriptFee(refScriptSize))
+	return ret, nil
+}
+
+// scriptFee returns the cost of the provided execution units, rounded up
+func (p FeeParams) scriptFee(exUnits lcommon.ExUnits) (*big.Int, error) {
+	if exUnits.Memory == 0 && exUnits.Steps == 0 {
+		return new(big.Int), nil
+	}
+	memPrice := p.executionCosts.MemPrice
+	stepPrice := p.executionCosts.StepPrice
+	if memPrice == nil || memPrice.Rat == nil ||
+		stepPrice == nil || stepPrice.Rat == nil {
+		return nil, errors.New("execution prices are not available")
+	}
+	cost := new(big.Rat).Mul(big.NewRat(exUnits.Memory, 1), memPrice.Rat)
+	cost.Add(
+		cost,
+		new(big.Rat).Mul(big.NewRat(exUnits.Steps, 1), stepPrice.Rat),
+	)
+	return ratCeil(cost), nil
+}
+
+// refScriptFee returns the fee for reference scripts of the provided total
+// size. Each tier of refScriptFeeTierSize bytes costs
+// refScriptFeeTierMultiplier times as much per byte as the previous one, and
+// the total is rounded down.
+func (p FeeParams) refScriptFee(size int) *big.Int {
+	if size <= 0 || p.minFeeRefScriptCostPerByte == nil ||
+		p.minFeeRefScriptCostPerByte.Rat == nil {
+		return new(big.Int)
+	}
+	total := new(big.Rat)
+	tierPrice := new(big.Rat).Set(p.minFeeRefScriptCostPerByte.Rat)
+	for remaining := size; remaining > 0; remaining -= refScriptFeeTierSize {
+		tierBytes := min(remaining, refScriptFeeTierSize)
+		total.Add(
+			total,
+			new(big.Rat).Mul(big.NewRat(int64(tierBytes), 1), tierPrice),
+		)
+		tierPrice.Mul(tierPrice, refScriptFeeTierMultiplier)
+	}
+	return new(big.Int).Quo(total.Num(), total.Denom())
+}
+
+// RefScriptSize returns the total size of the reference scripts in the
+// outputs spent or referenced by a transaction. A script is counted once for
+// each output which carries it.
+func RefScriptSize(resolvedUtxos map[string]ledger.Utxo) int {
+	var ret int
+	for _, utxo := range resolvedUtxos {
+		if utxo.Output == nil {
+			continue
+		}
+		if scriptRef := utxo.Output.ScriptRef(); scriptRef != nil {
+			ret += len(scriptRef.RawScriptBytes())
+		}
+	}
+	return ret
+}
+
+// ratCeil returns the smallest integer which isn't less than the provided
+// non-negative rational
+func ratCeil(r *big.Rat) *big.Int {
+	quo, rem := new(big.Int).QuoRem(r.Num(), r.Denom(), new(big.Int))
+	if rem.Sign() > 0 {
+		quo.Add(quo, big.NewInt(1))
+	}
+	return quo
+}
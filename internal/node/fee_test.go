@@ -0,0 +1,145 @@
+// Copyright 2025 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/blinklabs-io/gouroboros/cbor"
+	"github.com/blinklabs-io/gouroboros/ledger"
+	lcommon "github.com/blinklabs-io/gouroboros/ledger/common"
+)
+
+func testFeeParams() FeeParams {
+	return FeeParams{
+		minFeeA: 44,
+		minFeeB: 155381,
+		executionCosts: lcommon.ExUnitPrice{
+			MemPrice:  &cbor.Rat{Rat: big.NewRat(577, 10000)},
+			StepPrice: &cbor.Rat{Rat: big.NewRat(721, 10000000)},
+		},
+		minFeeRefScriptCostPerByte: &cbor.Rat{Rat: big.NewRat(15, 1)},
+	}
+}
+
+func TestFeeParamsScriptFee(t *testing.T) {
+	testDefs := []struct {
+		exUnits lcommon.ExUnits
+		want    int64
+	}{
+		{exUnits: lcommon.ExUnits{}, want: 0},
+		// 10000 * 0.0577 + 10000000 * 0.0000721 = 577 + 721
+		{
+			exUnits: lcommon.ExUnits{Memory: 10000, Steps: 10000000},
+			want:    1298,
+		},
+		// 1 * 0.0577 + 1 * 0.0000721 is rounded up
+		{exUnits: lcommon.ExUnits{Memory: 1, Steps: 1}, want: 1},
+	}
+	params := testFeeParams()
+	for _, testDef := range testDefs {
+		got, err := params.scriptFee(testDef.exUnits)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if got.Int64() != testDef.want {
+			t.Fatalf(
+				"unexpected script fee for %+v: got %s, wanted %d",
+				testDef.exUnits,
+				got,
+				testDef.want,
+			)
+		}
+	}
+
+	_, err := (FeeParams{}).scriptFee(lcommon.ExUnits{Memory: 1})
+	if err == nil {
+		t.Fatalf("expected error without execution prices")
+	}
+}
+
+func TestFeeParamsRefScriptFee(t *testing.T) {
+	testDefs := []struct {
+		size int
+		want int64
+	}{
+		{size: 0, want: 0},
+		{size: 100, want: 1500},
+		{size: 25600, want: 384000},
+		// The second tier costs 18 per byte
+		{size: 30000, want: 384000 + 4400*18},
+		// The third tier costs 21.6 per byte, and the total is rounded down
+		{size: 51201, want: 384000 + 460800 + 21},
+	}
+	params := testFeeParams()
+	for _, testDef := range testDefs {
+		got := params.refScriptFee(testDef.size)
+		if got.Int64() != testDef.want {
+			t.Fatalf(
+				"unexpected reference script fee for %d bytes: got %s, wanted %d",
+				testDef.size,
+				got,
+				testDef.want,
+			)
+		}
+	}
+
+	params.minFeeRefScriptCostPerByte = nil
+	if got := params.refScriptFee(100); got.Sign() != 0 {
+		t.Fatalf("unexpected reference script fee before Conway: %s", got)
+	}
+}
+
+func TestFeeParamsMinFee(t *testing.T) {
+	tx := testIndexTx(t, 0, 1)
+	txSize, err := lcommon.TxSizeForFee(tx)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	params := testFeeParams()
+	got, err := params.MinFee(
+		tx,
+		lcommon.ExUnits{Memory: 10000, Steps: 10000000},
+		100,
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	want := int64(44*txSize + 155381 + 1298 + 1500)
+	if got.Int64() != want {
+		t.Fatalf("unexpected min fee: got %s, wanted %d", got, want)
+	}
+}
+
+func TestFeeParamsFromProtocolParams(t *testing.T) {
+	if _, err := FeeParamsFromProtocolParams(
+		&ledger.ShelleyProtocolParameters{},
+	); err == nil {
+		t.Fatalf("expected error for pre-Alonzo protocol params")
+	}
+	params, err := FeeParamsFromProtocolParams(
+		&ledger.ConwayProtocolParameters{
+			MinFeeA:                    44,
+			MinFeeRefScriptCostPerByte: &cbor.Rat{Rat: big.NewRat(15, 1)},
+		},
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if params.minFeeA != 44 || params.minFeeRefScriptCostPerByte == nil {
+		t.Fatalf("unexpected fee params: %+v", params)
+	}
+}
@@ -0,0 +1,310 @@
+// Copyright 2025 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/blinklabs-io/adder/event"
+	"github.com/blinklabs-io/cardano-node-api/internal/logging"
+	ouroboros "github.com/blinklabs-io/gouroboros"
+	"github.com/blinklabs-io/gouroboros/protocol/chainsync"
+	ocommon "github.com/blinklabs-io/gouroboros/protocol/common"
+)
+
+const (
+	// EventTypeResync is emitted by a ChainFollower after it has reconnected
+	// to the node and re-intersected the chain. It is followed by a
+	// chainsync.rollback event for the point the stream resumes from.
+	EventTypeResync = "chainsync.resync"
+
+	followerMinBackoff = 1 * time.Second
+	followerMaxBackoff = 30 * time.Second
+	// Number of recently delivered block points offered to the node when
+	// re-intersecting, so that a fork during the outage can still be resolved
+	followerPointHistory = 20
+)
+
+// ResyncEvent is the payload for EventTypeResync events
+type ResyncEvent struct {
+	BlockHash  string `json:"blockHash"`
+	Reason     string `json:"reason"`
+	SlotNumber uint64 `json:"slotNumber"`
+	Attempts   int    `json:"attempts"`
+}
+
+// ChainFollower runs chain-sync against the node and transparently
+// reconnects with backoff when the connection fails, resuming from the last
+// point delivered to the consumer
+type ChainFollower struct {
+	eventChan chan event.Event
+	points    []ocommon.Point
+	fromTip   bool
+	dialFunc  func(*ConnectionConfig) (*ouroboros.Connection, error)
+	oConn     *ouroboros.Connection
+	connChan  chan event.Event
+	doneChan  chan struct{}
+	cancel    context.CancelFunc
+}
+
+// NewChainFollower creates a chain follower starting at the provided
+// intersect points, or at the current chain tip if no points are provided
+func NewChainFollower(intersectPoints []ocommon.Point) *ChainFollower {
+	return &ChainFollower{
+		eventChan: make(chan event.Event, 10),
+		points:    intersectPoints,
+		fromTip:   len(intersectPoints) == 0,
+		dialFunc:  GetConnection,
+		doneChan:  make(chan struct{}),
+	}
+}
+
+// Start performs the initial connection and intersection. Errors from this
+// first attempt are returned directly, as they are most likely caused by
+// the request rather than a transient node issue.
+func (f *ChainFollower) Start(ctx context.Context) error {
+	if err := f.connect(); err != nil {
+		return err
+	}
+	ctx, f.cancel = context.WithCancel(ctx)
+	go f.run(ctx)
+	return nil
+}
+
+// Stop stops following the chain and waits for the event channel to be
+// closed
+func (f *ChainFollower) Stop() {
+	if f.cancel == nil {
+		// Not started
+		return
+	}
+	f.cancel()
+	<-f.doneChan
+}
+
+// EventChan returns the channel on which chain-sync events are delivered. It
+// is closed once the follower stops.
+func (f *ChainFollower) EventChan() <-chan event.Event {
+	return f.eventChan
+}
+
+func (f *ChainFollower) connect() error {
+	connChan := make(chan event.Event, 10)
+	oConn, err := f.dialFunc(&ConnectionConfig{
+		ChainSyncEventChan: connChan,
+	})
+	if err != nil {
+		return err
+	}
+	if f.fromTip {
+		tip, err := oConn.ChainSync().Client.GetCurrentTip()
+		if err != nil {
+			closeConnection(oConn, connChan)
+			return fmt.Errorf("failed to get tip: %w", err)
+		}
+		f.points = []ocommon.Point{tip.Point}
+		f.fromTip = false
+	}
+	if err := oConn.ChainSync().Client.Sync(f.points); err != nil {
+		closeConnection(oConn, connChan)
+		return err
+	}
+	f.oConn = oConn
+	f.connChan = connChan
+	return nil
+}
+
+func (f *ChainFollower) run(ctx context.Context) {
+	defer func() {
+		if f.oConn != nil {
+			closeConnection(f.oConn, f.connChan)
+		}
+		close(f.eventChan)
+		close(f.doneChan)
+	}()
+	logger := logging.GetLogger().With("component", "chainsync")
+	for {
+		err := f.forward(ctx)
+		if err == nil {
+			// Context was cancelled
+			return
+		}
+		closeConnection(f.oConn, f.connChan)
+		f.oConn = nil
+		logger.Warn(
+			"chain-sync connection failed, reconnecting",
+			"error", err,
+		)
+		attempts, ok := f.reconnect(ctx)
+		if !ok {
+			return
+		}
+		var resumePoint ocommon.Point
+		if len(f.points) > 0 {
+			resumePoint = f.points[0]
+		}
+		logger.Info(
+			"chain-sync reconnected",
+			"slot", resumePoint.Slot,
+			"hash", hex.EncodeToString(resumePoint.Hash),
+			"attempts", attempts,
+		)
+		evt := event.New(
+			EventTypeResync,
+			time.Now(),
+			nil,
+			ResyncEvent{
+				BlockHash:  hex.EncodeToString(resumePoint.Hash),
+				SlotNumber: resumePoint.Slot,
+				Reason:     err.Error(),
+				Attempts:   attempts,
+			},
+		)
+		if !f.send(ctx, evt) {
+			return
+		}
+	}
+}
+
+// forward delivers events from the current connection until it fails or the
+// context is cancelled. It returns nil only on context cancellation.
+func (f *ChainFollower) forward(ctx context.Context) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case err, ok := <-f.oConn.ErrorChan():
+			// Deliver anything the node sent before the failure
+		drain:
+			for {
+				select {
+				case evt := <-f.connChan:
+					if !f.send(ctx, evt) {
+						return nil
+					}
+				default:
+					break drain
+				}
+			}
+			if !ok {
+				return errors.New("connection closed")
+			}
+			return err
+		case evt := <-f.connChan:
+			if !f.send(ctx, evt) {
+				return nil
+			}
+		}
+	}
+}
+
+func (f *ChainFollower) reconnect(ctx context.Context) (int, bool) {
+	backoff := followerMinBackoff
+	for attempt := 1; ; attempt++ {
+		select {
+		case <-ctx.Done():
+			return attempt, false
+		case <-time.After(backoff):
+		}
+		err := f.connect()
+		if err == nil {
+			return attempt, true
+		}
+		if errors.Is(err, chainsync.ErrIntersectNotFound) {
+			// None of our recent points are on the chain anymore, so we
+			// cannot resume without skipping blocks
+			logging.GetLogger().Error(
+				"chain-sync could not resume from last delivered point",
+				"component", "chainsync",
+				"error", err,
+			)
+			return attempt, false
+		}
+		logging.GetLogger().Debug(
+			"chain-sync reconnect attempt failed",
+			"component", "chainsync",
+			"attempt", attempt,
+			"error", err,
+		)
+		backoff = min(backoff*2, followerMaxBackoff)
+	}
+}
+
+// send delivers an event to the consumer and records its point for resuming
+func (f *ChainFollower) send(ctx context.Context, evt event.Event) bool {
+	select {
+	case <-ctx.Done():
+		return false
+	case f.eventChan <- evt:
+	}
+	f.trackPoint(evt)
+	return true
+}
+
+func (f *ChainFollower) trackPoint(evt event.Event) {
+	switch payload := evt.Payload.(type) {
+	case event.BlockEvent:
+		if payload.Block == nil {
+			return
+		}
+		point := ocommon.NewPoint(
+			payload.Block.SlotNumber(),
+			payload.Block.Hash().Bytes(),
+		)
+		f.points = append([]ocommon.Point{point}, f.points...)
+		if len(f.points) > followerPointHistory {
+			f.points = f.points[:followerPointHistory]
+		}
+	case event.RollbackEvent:
+		hash, err := hex.DecodeString(payload.BlockHash)
+		if err != nil {
+			return
+		}
+		// Drop any points past the rollback
+		kept := make([]ocommon.Point, 0, len(f.points)+1)
+		for _, point := range f.points {
+			if point.Slot < payload.SlotNumber {
+				kept = append(kept, point)
+			}
+		}
+		rollbackPoint := ocommon.NewPointOrigin()
+		if payload.SlotNumber > 0 || len(hash) > 0 {
+			rollbackPoint = ocommon.NewPoint(payload.SlotNumber, hash)
+		}
+		f.points = append([]ocommon.Point{rollbackPoint}, kept...)
+	}
+}
+
+// closeConnection closes a chain-sync connection while draining its event
+// channel, so that a chain-sync callback blocked on a send cannot prevent the
+// connection from shutting down
+func closeConnection(oConn *ouroboros.Connection, connChan chan event.Event) {
+	doneChan := make(chan struct{})
+	go func() {
+		for {
+			select {
+			case <-connChan:
+			case <-doneChan:
+				return
+			}
+		}
+	}()
+	oConn.Close()
+	close(doneChan)
+}
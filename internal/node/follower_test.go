@@ -0,0 +1,59 @@
+// Copyright 2025 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/blinklabs-io/adder/event"
+	ocommon "github.com/blinklabs-io/gouroboros/protocol/common"
+)
+
+func TestChainFollowerRollbackDropsLaterPoints(t *testing.T) {
+	f := NewChainFollower([]ocommon.Point{
+		ocommon.NewPoint(300, []byte{0x03}),
+		ocommon.NewPoint(200, []byte{0x02}),
+		ocommon.NewPoint(100, []byte{0x01}),
+	})
+
+	f.trackPoint(event.New(
+		"chainsync.rollback",
+		time.Now(),
+		nil,
+		event.RollbackEvent{
+			BlockHash:  hex.EncodeToString([]byte{0x02}),
+			SlotNumber: 200,
+		},
+	))
+
+	if len(f.points) != 2 {
+		t.Fatalf("points = %d, want 2", len(f.points))
+	}
+	if f.points[0].Slot != 200 || f.points[1].Slot != 100 {
+		t.Fatalf(
+			"points = [%d %d], want [200 100]",
+			f.points[0].Slot,
+			f.points[1].Slot,
+		)
+	}
+}
+
+func TestChainFollowerStopWithoutStart(t *testing.T) {
+	f := NewChainFollower(nil)
+	// Must not block when Start was never called or failed
+	f.Stop()
+}
@@ -0,0 +1,125 @@
+// Copyright 2025 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/blinklabs-io/gouroboros/cbor"
+	lcommon "github.com/blinklabs-io/gouroboros/ledger/common"
+	"github.com/btcsuite/btcd/btcutil/bech32"
+)
+
+// CIP-0129 key types, which are stored in the high nibble of the header byte
+const (
+	Cip129KeyTypeCommitteeHot  uint8 = 0
+	Cip129KeyTypeCommitteeCold uint8 = 1
+	Cip129KeyTypeDrep          uint8 = 2
+)
+
+// EncodeCip129Credential returns the CIP-0129 bech32 encoding of a governance
+// credential. The credential type is 0 for a key hash or 1 for a script hash.
+func EncodeCip129Credential(
+	prefix string,
+	keyType uint8,
+	credType uint64,
+	hash []byte,
+) (string, error) {
+	if credType > lcommon.CredentialTypeScriptHash {
+		return "", fmt.Errorf("unknown credential type %d", credType)
+	}
+	// The low nibble is the credential type offset by 2, as the values 0 and
+	// 1 are reserved
+	data := make([]byte, 1+len(hash))
+	data[0] = (keyType << 4) | byte(credType+2)
+	copy(data[1:], hash)
+	convData, err := bech32.ConvertBits(data, 8, 5, true)
+	if err != nil {
+		return "", err
+	}
+	return bech32.Encode(prefix, convData)
+}
+
+// ParseGovActionId parses a governance action ID in the form
+// <tx hash>#<index>
+func ParseGovActionId(govActionId string) (lcommon.GovActionId, error) {
+	txIn, err := ParseTxIn(govActionId)
+	if err != nil {
+		return lcommon.GovActionId{}, err
+	}
+	return lcommon.GovActionId{
+		TransactionId: txIn.TxId,
+		GovActionIdx:  txIn.OutputIndex,
+	}, nil
+}
+
+// FormatGovActionId returns a governance action ID in the form
+// <tx hash>#<index>
+func FormatGovActionId(govActionId lcommon.GovActionId) string {
+	return fmt.Sprintf(
+		"%s#%d",
+		lcommon.Blake2b256(govActionId.TransactionId).String(),
+		govActionId.GovActionIdx,
+	)
+}
+
+// DrepStake is the voting stake delegated to a DRep
+type DrepStake struct {
+	Drep  lcommon.Drep
+	Stake uint64
+}
+
+// DecodeDrepStakeDistr decodes the raw result of the DRep stake distribution
+// query. The result map is keyed by DRep, which can't be used as a Go map key,
+// so the entries are decoded individually.
+func DecodeDrepStakeDistr(data []byte) ([]DrepStake, error) {
+	if len(data) == 0 {
+		return nil, errors.New("empty DRep stake distribution")
+	}
+	dec, err := cbor.NewStreamDecoder(data)
+	if err != nil {
+		return nil, err
+	}
+	// The map may be wrapped in a single-element array by the era codec
+	if data[0]&cbor.CborTypeMask == cbor.CborTypeArray {
+		length, _, _, err := dec.DecodeArrayHeader()
+		if err != nil {
+			return nil, err
+		}
+		if length != 1 {
+			return nil, fmt.Errorf(
+				"unexpected DRep stake distribution wrapper length %d",
+				length,
+			)
+		}
+	}
+	count, _, _, err := dec.DecodeMapHeader()
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]DrepStake, 0, count)
+	for range count {
+		var tmpItem DrepStake
+		if _, _, err := dec.Decode(&tmpItem.Drep); err != nil {
+			return nil, fmt.Errorf("failed to decode DRep: %w", err)
+		}
+		if _, _, err := dec.Decode(&tmpItem.Stake); err != nil {
+			return nil, fmt.Errorf("failed to decode DRep stake: %w", err)
+		}
+		ret = append(ret, tmpItem)
+	}
+	return ret, nil
+}
@@ -0,0 +1,179 @@
+// Copyright 2025 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/blinklabs-io/gouroboros/cbor"
+	lcommon "github.com/blinklabs-io/gouroboros/ledger/common"
+	"github.com/btcsuite/btcd/btcutil/bech32"
+)
+
+func TestEncodeCip129Credential(t *testing.T) {
+	hash := bytes.Repeat([]byte{0xab}, lcommon.Blake2b224Size)
+	testDefs := []struct {
+		prefix     string
+		keyType    uint8
+		credType   uint64
+		wantHeader byte
+	}{
+		{
+			prefix:     "cc_hot",
+			keyType:    Cip129KeyTypeCommitteeHot,
+			credType:   lcommon.CredentialTypeAddrKeyHash,
+			wantHeader: 0x02,
+		},
+		{
+			prefix:     "cc_cold",
+			keyType:    Cip129KeyTypeCommitteeCold,
+			credType:   lcommon.CredentialTypeScriptHash,
+			wantHeader: 0x13,
+		},
+		{
+			prefix:     "drep",
+			keyType:    Cip129KeyTypeDrep,
+			credType:   lcommon.CredentialTypeAddrKeyHash,
+			wantHeader: 0x22,
+		},
+	}
+	for _, testDef := range testDefs {
+		encoded, err := EncodeCip129Credential(
+			testDef.prefix,
+			testDef.keyType,
+			testDef.credType,
+			hash,
+		)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		hrp, data, err := bech32.DecodeToBase256(encoded)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if hrp != testDef.prefix {
+			t.Errorf(
+				"unexpected prefix: got %s, expected %s",
+				hrp,
+				testDef.prefix,
+			)
+		}
+		if data[0] != testDef.wantHeader {
+			t.Errorf(
+				"unexpected header for %s: got %#x, expected %#x",
+				encoded,
+				data[0],
+				testDef.wantHeader,
+			)
+		}
+		if !bytes.Equal(data[1:], hash) {
+			t.Errorf("unexpected hash for %s", encoded)
+		}
+	}
+	// DRep IDs should match the encoding used by the ledger library
+	drep := lcommon.Drep{Type: lcommon.DrepTypeAddrKeyHash, Credential: hash}
+	encoded, err := EncodeCip129Credential(
+		"drep",
+		Cip129KeyTypeDrep,
+		lcommon.CredentialTypeAddrKeyHash,
+		hash,
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if encoded != drep.String() {
+		t.Errorf(
+			"unexpected DRep ID: got %s, expected %s",
+			encoded,
+			drep.String(),
+		)
+	}
+	_, err = EncodeCip129Credential("drep", Cip129KeyTypeDrep, 2, hash)
+	if err == nil {
+		t.Error("expected error for unknown credential type")
+	}
+}
+
+func TestParseGovActionId(t *testing.T) {
+	// Indexes above 255 must round trip
+	govActionIdStr := "9f6a2cd3c2a25e1f4a6ec0f8c8ec1e30d4a5e5d0b6a56cb4df0b7a6b2f23e8d1#300"
+	govActionId, err := ParseGovActionId(govActionIdStr)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if govActionId.GovActionIdx != 300 {
+		t.Fatalf("unexpected index: %d", govActionId.GovActionIdx)
+	}
+	if got := FormatGovActionId(govActionId); got != govActionIdStr {
+		t.Fatalf(
+			"unexpected action ID: got %s, expected %s",
+			got,
+			govActionIdStr,
+		)
+	}
+	if _, err := ParseGovActionId("deadbeef#0"); err == nil {
+		t.Fatal("expected error for short transaction hash")
+	}
+}
+
+func TestDecodeDrepStakeDistr(t *testing.T) {
+	dreps := []lcommon.Drep{
+		{
+			Type:       lcommon.DrepTypeAddrKeyHash,
+			Credential: bytes.Repeat([]byte{0x01}, lcommon.Blake2b224Size),
+		},
+		{Type: lcommon.DrepTypeAbstain},
+	}
+	stakes := []uint64{1000000, 42}
+	// Build the map by hand, as DReps can't be used as Go map keys
+	mapData := []byte{0xa0 | byte(len(dreps))}
+	for idx, drep := range dreps {
+		keyData, err := cbor.Encode(drep)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		valueData, err := cbor.Encode(stakes[idx])
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		mapData = append(mapData, keyData...)
+		mapData = append(mapData, valueData...)
+	}
+	testDefs := [][]byte{
+		mapData,
+		// Map wrapped in a single-element array
+		append([]byte{0x81}, mapData...),
+	}
+	for _, testDef := range testDefs {
+		got, err := DecodeDrepStakeDistr(testDef)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if len(got) != len(dreps) {
+			t.Fatalf("unexpected entry count: %d", len(got))
+		}
+		for idx, tmpItem := range got {
+			if tmpItem.Drep.Type != dreps[idx].Type ||
+				!bytes.Equal(tmpItem.Drep.Credential, dreps[idx].Credential) ||
+				tmpItem.Stake != stakes[idx] {
+				t.Errorf("unexpected entry %d: %+v", idx, tmpItem)
+			}
+		}
+	}
+	if _, err := DecodeDrepStakeDistr(nil); err == nil {
+		t.Error("expected error for empty input")
+	}
+}
@@ -0,0 +1,198 @@
+// Copyright 2025 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/blinklabs-io/cardano-node-api/internal/config"
+)
+
+// HealthStatus describes the health of our connection to the node
+type HealthStatus struct {
+	// Live reports whether the node is reachable and, if a liveness tip age
+	// is configured, whether its tip is not too far behind wall-clock time
+	Live bool
+	// Ready reports whether the node is live and its tip is recent enough
+	// to serve requests
+	Ready     bool
+	TipSlot   uint64
+	TipTime   time.Time
+	TipAge    time.Duration
+	CheckedAt time.Time
+	Error     string
+}
+
+// HealthChecker probes the node and compares the slot of its chain tip
+// against wall-clock time. Results are cached briefly so that frequent
+// probes do not put extra load on the node.
+type HealthChecker struct {
+	mu             sync.Mutex
+	readyMaxTipAge time.Duration
+	liveMaxTipAge  time.Duration
+	cacheTime      time.Duration
+	probeFunc      func(context.Context) (uint64, time.Time, error)
+	nowFunc        func() time.Time
+	lastStatus     *HealthStatus
+}
+
+var (
+	globalHealthChecker     *HealthChecker
+	globalHealthCheckerOnce sync.Once
+)
+
+// GetHealthChecker returns the global health checker, creating it from the
+// config on first use
+func GetHealthChecker() *HealthChecker {
+	globalHealthCheckerOnce.Do(func() {
+		cfg := config.GetConfig()
+		globalHealthChecker = NewHealthChecker(
+			time.Duration(cfg.Health.ReadyMaxTipAge)*time.Second,
+			time.Duration(cfg.Health.LiveMaxTipAge)*time.Second,
+			time.Duration(cfg.Health.CacheTime)*time.Second,
+		)
+	})
+	return globalHealthChecker
+}
+
+// NewHealthChecker creates a health checker. A max tip age of 0 disables the
+// tip age check for that probe.
+func NewHealthChecker(
+	readyMaxTipAge time.Duration,
+	liveMaxTipAge time.Duration,
+	cacheTime time.Duration,
+) *HealthChecker {
+	return &HealthChecker{
+		readyMaxTipAge: readyMaxTipAge,
+		liveMaxTipAge:  liveMaxTipAge,
+		cacheTime:      cacheTime,
+		probeFunc:      probeTip,
+		nowFunc:        time.Now,
+	}
+}
+
+// Check returns the current health status, probing the node if the cached
+// status has expired. The lock isn't held while probing, so a slow node
+// doesn't hold up callers which can use the cached status.
+func (h *HealthChecker) Check(ctx context.Context) HealthStatus {
+	h.mu.Lock()
+	now := h.nowFunc()
+	if h.lastStatus != nil && now.Sub(h.lastStatus.CheckedAt) < h.cacheTime {
+		status := *h.lastStatus
+		h.mu.Unlock()
+		return status
+	}
+	h.mu.Unlock()
+	status := HealthStatus{
+		CheckedAt: now,
+	}
+	tipSlot, tipTime, err := h.probeFunc(ctx)
+	if err != nil {
+		status.Error = err.Error()
+	} else {
+		status.TipSlot = tipSlot
+		status.TipTime = tipTime
+		status.TipAge = max(now.Sub(tipTime), 0)
+		status.Live = true
+		status.Ready = true
+		if h.liveMaxTipAge > 0 && status.TipAge > h.liveMaxTipAge {
+			status.Live = false
+			status.Ready = false
+			status.Error = fmt.Sprintf(
+				"node tip is %s behind wall-clock time",
+				status.TipAge.Round(time.Second),
+			)
+		} else if h.readyMaxTipAge > 0 && status.TipAge > h.readyMaxTipAge {
+			status.Ready = false
+			status.Error = fmt.Sprintf(
+				"node tip is %s behind wall-clock time",
+				status.TipAge.Round(time.Second),
+			)
+		}
+	}
+	// A probe cut short by the caller going away says nothing about the
+	// node, so it isn't cached for other callers
+	if err != nil && ctx.Err() != nil {
+		return status
+	}
+	h.mu.Lock()
+	if h.lastStatus == nil || !status.CheckedAt.Before(h.lastStatus.CheckedAt) {
+		h.lastStatus = &status
+	}
+	h.mu.Unlock()
+	return status
+}
+
+// probeTip queries the node for its tip and converts the tip slot to
+// wall-clock time using the system start and era history. The probe uses its
+// own connection rather than the pool, so that a busy pool doesn't fail the
+// health checks, and gives up after the node timeout.
+func probeTip(ctx context.Context) (uint64, time.Time, error) {
+	if timeout := config.GetConfig().Node.Timeout; timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(
+			ctx,
+			time.Duration(timeout)*time.Second,
+		)
+		defer cancel()
+	}
+	type probeResult struct {
+		tipSlot uint64
+		tipTime time.Time
+		err     error
+	}
+	resultChan := make(chan probeResult, 1)
+	go func() {
+		tipSlot, tipTime, err := probeTipConn(ctx)
+		resultChan <- probeResult{tipSlot, tipTime, err}
+	}()
+	select {
+	case <-ctx.Done():
+		return 0, time.Time{}, fmt.Errorf("probe node: %w", ctx.Err())
+	case result := <-resultChan:
+		return result.tipSlot, result.tipTime, result.err
+	}
+}
+
+func probeTipConn(ctx context.Context) (uint64, time.Time, error) {
+	oConn, err := GetConnection(nil)
+	if err != nil {
+		return 0, time.Time{}, err
+	}
+	// The connection is closed once the probe is done or has timed out,
+	// which also stops a query which is still waiting on the node
+	context.AfterFunc(ctx, func() {
+		oConn.Close()
+	})
+	defer oConn.Close()
+	client := oConn.LocalStateQuery().Client
+	client.Start()
+	point, err := client.GetChainPoint()
+	if err != nil {
+		return 0, time.Time{}, fmt.Errorf("get chain point: %w", err)
+	}
+	systemStartMs, eraHistory, err := QueryEraHistory(client)
+	if err != nil {
+		return 0, time.Time{}, err
+	}
+	tipMs, err := SlotToPOSIXTime(point.Slot, systemStartMs, eraHistory)
+	if err != nil {
+		return 0, time.Time{}, fmt.Errorf("convert tip slot: %w", err)
+	}
+	return point.Slot, time.UnixMilli(tipMs).UTC(), nil
+}
@@ -0,0 +1,133 @@
+// Copyright 2025 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func testHealthChecker(
+	now time.Time,
+	tipTime time.Time,
+	probeErr error,
+) (*HealthChecker, *int) {
+	probeCount := 0
+	h := NewHealthChecker(5*time.Minute, 30*time.Minute, 5*time.Second)
+	h.nowFunc = func() time.Time {
+		return now
+	}
+	h.probeFunc = func(context.Context) (uint64, time.Time, error) {
+		probeCount++
+		return 1000, tipTime, probeErr
+	}
+	return h, &probeCount
+}
+
+func TestHealthCheckerTipAge(t *testing.T) {
+	now := time.Date(2025, time.June, 1, 0, 0, 0, 0, time.UTC)
+	testCases := []struct {
+		name      string
+		tipAge    time.Duration
+		probeErr  error
+		wantLive  bool
+		wantReady bool
+	}{
+		{
+			name:      "recent tip",
+			tipAge:    20 * time.Second,
+			wantLive:  true,
+			wantReady: true,
+		},
+		{
+			name:      "stale tip is live but not ready",
+			tipAge:    10 * time.Minute,
+			wantLive:  true,
+			wantReady: false,
+		},
+		{
+			name:      "very stale tip is not live",
+			tipAge:    time.Hour,
+			wantLive:  false,
+			wantReady: false,
+		},
+		{
+			name:      "unreachable node",
+			probeErr:  errors.New("connection refused"),
+			wantLive:  false,
+			wantReady: false,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			h, _ := testHealthChecker(now, now.Add(-tc.tipAge), tc.probeErr)
+			status := h.Check(context.Background())
+			if status.Live != tc.wantLive {
+				t.Fatalf("Live = %v, want %v", status.Live, tc.wantLive)
+			}
+			if status.Ready != tc.wantReady {
+				t.Fatalf("Ready = %v, want %v", status.Ready, tc.wantReady)
+			}
+			if !tc.wantReady && status.Error == "" {
+				t.Fatal("expected an error message for unhealthy status")
+			}
+		})
+	}
+}
+
+func TestHealthCheckerCachesStatus(t *testing.T) {
+	now := time.Date(2025, time.June, 1, 0, 0, 0, 0, time.UTC)
+	h, probeCount := testHealthChecker(now, now, nil)
+
+	h.Check(context.Background())
+	h.Check(context.Background())
+	if *probeCount != 1 {
+		t.Fatalf("probe count = %d, want 1", *probeCount)
+	}
+	h.nowFunc = func() time.Time {
+		return now.Add(10 * time.Second)
+	}
+	h.Check(context.Background())
+	if *probeCount != 2 {
+		t.Fatalf("probe count = %d, want 2", *probeCount)
+	}
+}
+
+func TestHealthCheckerSkipsCancelledProbe(t *testing.T) {
+	now := time.Date(2025, time.June, 1, 0, 0, 0, 0, time.UTC)
+	h, probeCount := testHealthChecker(now, now, nil)
+	h.probeFunc = func(ctx context.Context) (uint64, time.Time, error) {
+		*probeCount++
+		if err := ctx.Err(); err != nil {
+			return 0, time.Time{}, err
+		}
+		return 1000, now, nil
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if status := h.Check(ctx); status.Live {
+		t.Fatal("expected cancelled probe to not be live")
+	}
+	// The cancelled probe isn't cached
+	if status := h.Check(context.Background()); !status.Live {
+		t.Fatalf("expected live status, got error: %s", status.Error)
+	}
+	if *probeCount != 2 {
+		t.Fatalf("probe count = %d, want 2", *probeCount)
+	}
+}
@@ -18,6 +18,7 @@ import (
 	"time"
 
 	"github.com/blinklabs-io/cardano-node-api/internal/config"
+	"github.com/blinklabs-io/gouroboros/ledger"
 	"github.com/blinklabs-io/gouroboros/protocol/localtxsubmission"
 )
 
@@ -30,3 +31,54 @@ func buildLocalTxSubmissionConfig() localtxsubmission.Config {
 		),
 	)
 }
+
+const (
+	TxSubmitStatusSubmitted = "submitted"
+	TxSubmitStatusRejected  = "rejected"
+	TxSubmitStatusFailed    = "failed"
+	TxSubmitStatusSkipped   = "skipped"
+)
+
+// TxSubmitResult is the outcome of submitting one transaction of a batch
+type TxSubmitResult struct {
+	TxHash string          `json:"tx_hash"`
+	Status string          `json:"status"`
+	Error  string          `json:"error,omitempty"`
+	Reason *TxRejectReason `json:"reason,omitempty"`
+}
+
+// SubmitTxBatch submits transactions in order using the given submit
+// function, which is usually the SubmitTx method of a leased connection's
+// LocalTxSubmission client. After a failure, the remaining transactions are
+// skipped unless continueOnError is set.
+func SubmitTxBatch(
+	submitTx func(uint16, []byte) error,
+	txs []ledger.Transaction,
+	continueOnError bool,
+) []TxSubmitResult {
+	ret := make([]TxSubmitResult, 0, len(txs))
+	failed := false
+	for _, tx := range txs {
+		result := TxSubmitResult{
+			TxHash: tx.Hash().String(),
+			Status: TxSubmitStatusSubmitted,
+		}
+		if failed && !continueOnError {
+			result.Status = TxSubmitStatusSkipped
+			ret = append(ret, result)
+			continue
+		}
+		// #nosec G115
+		if err := submitTx(uint16(tx.Type()), tx.Cbor()); err != nil {
+			failed = true
+			result.Status = TxSubmitStatusFailed
+			result.Error = err.Error()
+			if reason, ok := TxRejectReasonFromError(err); ok {
+				result.Status = TxSubmitStatusRejected
+				result.Reason = reason
+			}
+		}
+		ret = append(ret, result)
+	}
+	return ret
+}
@@ -0,0 +1,127 @@
+// Copyright 2025 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"bytes"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/blinklabs-io/gouroboros/ledger"
+	"github.com/blinklabs-io/gouroboros/protocol/localtxsubmission"
+)
+
+func TestSubmitTxBatch(t *testing.T) {
+	txs := []ledger.Transaction{
+		testIndexTx(t, 0, 1),
+		testIndexTx(t, 1, 2),
+		testIndexTx(t, 2, 3),
+	}
+	testDefs := []struct {
+		name            string
+		continueOnError bool
+		submitErr       error
+		statuses        []string
+		submitted       int
+	}{
+		{
+			name: "AllSubmitted",
+			statuses: []string{
+				TxSubmitStatusSubmitted,
+				TxSubmitStatusSubmitted,
+				TxSubmitStatusSubmitted,
+			},
+			submitted: 3,
+		},
+		{
+			name: "StopOnRejection",
+			submitErr: localtxsubmission.TransactionRejectedError{
+				ReasonCbor: []byte{0xff},
+			},
+			statuses: []string{
+				TxSubmitStatusSubmitted,
+				TxSubmitStatusRejected,
+				TxSubmitStatusSkipped,
+			},
+			submitted: 2,
+		},
+		{
+			name:            "ContinueOnError",
+			continueOnError: true,
+			submitErr:       errors.New("connection closed"),
+			statuses: []string{
+				TxSubmitStatusSubmitted,
+				TxSubmitStatusFailed,
+				TxSubmitStatusSubmitted,
+			},
+			submitted: 3,
+		},
+	}
+	for _, testDef := range testDefs {
+		t.Run(testDef.name, func(t *testing.T) {
+			var submitted [][]byte
+			submitTx := func(txType uint16, txCbor []byte) error {
+				if txType != ledger.TxTypeConway {
+					t.Fatalf("did not get expected tx type: %d", txType)
+				}
+				submitted = append(submitted, txCbor)
+				// Fail the second transaction
+				if len(submitted) == 2 {
+					return testDef.submitErr
+				}
+				return nil
+			}
+			results := SubmitTxBatch(submitTx, txs, testDef.continueOnError)
+			statuses := make([]string, 0, len(results))
+			for idx, result := range results {
+				if result.TxHash != txs[idx].Hash().String() {
+					t.Fatalf("did not get expected tx hash: %s", result.TxHash)
+				}
+				statuses = append(statuses, result.Status)
+			}
+			if !slices.Equal(statuses, testDef.statuses) {
+				t.Fatalf(
+					"did not get expected statuses: got %v, wanted %v",
+					statuses,
+					testDef.statuses,
+				)
+			}
+			if len(submitted) != testDef.submitted {
+				t.Fatalf(
+					"did not submit expected transactions: got %d, wanted %d",
+					len(submitted),
+					testDef.submitted,
+				)
+			}
+			// Transactions are submitted in order
+			for idx, txCbor := range submitted {
+				if !bytes.Equal(txCbor, txs[idx].Cbor()) {
+					t.Fatalf("did not submit transaction %d in order", idx)
+				}
+			}
+			if testDef.submitErr != nil {
+				failed := results[1]
+				if failed.Error != testDef.submitErr.Error() {
+					t.Fatalf("did not get expected error: %s", failed.Error)
+				}
+				if (failed.Reason != nil) !=
+					(failed.Status == TxSubmitStatusRejected) {
+					t.Fatalf("did not get expected reason: %v", failed.Reason)
+				}
+			}
+		})
+	}
+}
@@ -28,7 +28,17 @@ type ConnectionConfig struct {
 	ChainSyncEventChan chan event.Event
 }
 
+// GetConnection connects to the healthiest configured upstream node, failing
+// over to the others if the connection fails
 func GetConnection(connCfg *ConnectionConfig) (*ouroboros.Connection, error) {
+	oConn, _, err := GetUpstreams().Dial(connCfg)
+	return oConn, err
+}
+
+func dialUpstream(
+	upstream config.UpstreamConfig,
+	connCfg *ConnectionConfig,
+) (*ouroboros.Connection, error) {
 	// Make sure we always have a ConnectionConfig object
 	if connCfg == nil {
 		connCfg = &ConnectionConfig{}
@@ -48,24 +58,24 @@ func GetConnection(connCfg *ConnectionConfig) (*ouroboros.Connection, error) {
 		return nil, fmt.Errorf("failure creating Ouroboros connection: %w", err)
 	}
 
-	if cfg.Node.Address != "" && cfg.Node.Port > 0 {
+	if upstream.Address != "" && upstream.Port > 0 {
 		// Connect to TCP port
-		if err := oConn.Dial("tcp", fmt.Sprintf("%s:%d", cfg.Node.Address, cfg.Node.Port)); err != nil {
+		if err := oConn.Dial("tcp", fmt.Sprintf("%s:%d", upstream.Address, upstream.Port)); err != nil {
 			return nil, fmt.Errorf(
 				"failure connecting to node via TCP: %w",
 				err,
 			)
 		}
-	} else if cfg.Node.SocketPath != "" {
+	} else if upstream.SocketPath != "" {
 		// Check that node socket path exists
-		if _, err := os.Stat(cfg.Node.SocketPath); err != nil {
+		if _, err := os.Stat(upstream.SocketPath); err != nil {
 			if os.IsNotExist(err) {
-				return nil, fmt.Errorf("node socket path does not exist: %s", cfg.Node.SocketPath)
+				return nil, fmt.Errorf("node socket path does not exist: %s", upstream.SocketPath)
 			} else {
 				return nil, fmt.Errorf("unknown error checking if node socket path exists: %w", err)
 			}
 		}
-		if err := oConn.Dial("unix", cfg.Node.SocketPath); err != nil {
+		if err := oConn.Dial("unix", upstream.SocketPath); err != nil {
 			return nil, fmt.Errorf("failure connecting to node via UNIX socket: %w", err)
 		}
 	} else {
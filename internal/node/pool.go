@@ -0,0 +1,376 @@
+// Copyright 2025 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/blinklabs-io/cardano-node-api/internal/config"
+	"github.com/blinklabs-io/cardano-node-api/internal/logging"
+	ouroboros "github.com/blinklabs-io/gouroboros"
+	"github.com/blinklabs-io/gouroboros/protocol/chainsync"
+	"github.com/blinklabs-io/gouroboros/protocol/localstatequery"
+	"github.com/blinklabs-io/gouroboros/protocol/localtxmonitor"
+	"github.com/blinklabs-io/gouroboros/protocol/localtxsubmission"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	ErrPoolClosed      = errors.New("connection pool is closed")
+	ErrPoolWaitTimeout = errors.New(
+		"timed out waiting for a pooled node connection",
+	)
+)
+
+var (
+	metricPoolOpen = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "cardano_node_api_pool_connections_open",
+		Help: "Number of open pooled connections to cardano-node",
+	})
+	metricPoolInUse = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "cardano_node_api_pool_connections_in_use",
+		Help: "Number of pooled connections currently leased",
+	})
+	metricPoolWait = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name:    "cardano_node_api_pool_wait_seconds",
+		Help:    "Time spent waiting to lease a pooled connection",
+		Buckets: prometheus.ExponentialBuckets(0.0005, 4, 10),
+	})
+	metricPoolEvictions = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "cardano_node_api_pool_evictions_total",
+		Help: "Number of pooled connections evicted after an error",
+	})
+)
+
+var (
+	globalPool     *Pool
+	globalPoolOnce sync.Once
+)
+
+// GetPool returns the global connection pool, creating it from the config on
+// first use
+func GetPool() *Pool {
+	globalPoolOnce.Do(func() {
+		cfg := config.GetConfig()
+		globalPool = NewPool(
+			int(cfg.Node.PoolSize), // #nosec G115
+			time.Duration(cfg.Node.PoolWaitTimeout)*time.Second,
+			func() (*ouroboros.Connection, *Upstream, error) {
+				return GetUpstreams().Dial(nil)
+			},
+		)
+	})
+	return globalPool
+}
+
+// AcquireConnection leases a connection from the global connection pool
+func AcquireConnection(ctx context.Context) (*Lease, error) {
+	return GetPool().Acquire(ctx)
+}
+
+// DialFunc creates a new connection for the pool, along with the upstream it
+// is connected to. The upstream may be nil.
+type DialFunc func() (*ouroboros.Connection, *Upstream, error)
+
+// Pool keeps a bounded set of warm connections to cardano-node for the
+// request/response mini-protocols (LocalStateQuery, LocalTxMonitor and
+// LocalTxSubmission). Long-lived chain-sync streams should continue to use
+// GetConnection, as they need their own event channel.
+type Pool struct {
+	mu          sync.Mutex
+	dialFunc    DialFunc
+	waitTimeout time.Duration
+	slots       chan struct{}
+	idle        []*pooledConn
+	closed      bool
+}
+
+type pooledConn struct {
+	conn      *ouroboros.Connection
+	upstream  *Upstream
+	broken    atomic.Bool
+	closeOnce sync.Once
+}
+
+// NewPool creates a connection pool which holds up to size connections
+func NewPool(size int, waitTimeout time.Duration, dialFunc DialFunc) *Pool {
+	if size < 1 {
+		size = 1
+	}
+	return &Pool{
+		dialFunc:    dialFunc,
+		waitTimeout: waitTimeout,
+		slots:       make(chan struct{}, size),
+	}
+}
+
+// Size returns the maximum number of connections held by the pool
+func (p *Pool) Size() int {
+	return cap(p.slots)
+}
+
+// Warm dials connections until the pool holds its full number of idle
+// connections
+func (p *Pool) Warm() error {
+	leases := make([]*Lease, 0, p.Size())
+	defer func() {
+		for _, lease := range leases {
+			lease.Release()
+		}
+	}()
+	for range p.Size() {
+		lease, err := p.Acquire(context.Background())
+		if err != nil {
+			return err
+		}
+		leases = append(leases, lease)
+	}
+	return nil
+}
+
+// Acquire leases a connection from the pool, dialing a new one if no idle
+// connection is available. It blocks while all connections are leased, until
+// the context is done or the pool wait timeout expires. The returned lease
+// must be released when the caller is finished with it.
+func (p *Pool) Acquire(ctx context.Context) (*Lease, error) {
+	start := time.Now()
+	var timeoutChan <-chan time.Time
+	if p.waitTimeout > 0 {
+		timer := time.NewTimer(p.waitTimeout)
+		defer timer.Stop()
+		timeoutChan = timer.C
+	}
+	select {
+	case p.slots <- struct{}{}:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-timeoutChan:
+		return nil, ErrPoolWaitTimeout
+	}
+	metricPoolWait.Observe(time.Since(start).Seconds())
+	pc, err := p.getIdleOrDial()
+	if err != nil {
+		<-p.slots
+		return nil, err
+	}
+	metricPoolInUse.Inc()
+	return &Lease{
+		pool: p,
+		pc:   pc,
+	}, nil
+}
+
+// Close closes all idle connections and prevents further leases. Leased
+// connections are closed when they are released.
+func (p *Pool) Close() {
+	p.mu.Lock()
+	p.closed = true
+	idle := p.idle
+	p.idle = nil
+	p.mu.Unlock()
+	for _, pc := range idle {
+		pc.close()
+	}
+}
+
+func (p *Pool) getIdleOrDial() (*pooledConn, error) {
+	p.mu.Lock()
+	if p.closed {
+		p.mu.Unlock()
+		return nil, ErrPoolClosed
+	}
+	// Use the most recently returned healthy connection. Connections to an
+	// upstream which is no longer healthy are closed, so that new connections
+	// are routed to a better upstream.
+	for len(p.idle) > 0 {
+		pc := p.idle[len(p.idle)-1]
+		p.idle = p.idle[:len(p.idle)-1]
+		if !pc.broken.Load() &&
+			(pc.upstream == nil || pc.upstream.Healthy()) {
+			p.mu.Unlock()
+			return pc, nil
+		}
+		pc.close()
+	}
+	p.mu.Unlock()
+	conn, upstream, err := p.dialFunc()
+	if err != nil {
+		return nil, err
+	}
+	pc := &pooledConn{
+		conn:     conn,
+		upstream: upstream,
+	}
+	metricPoolOpen.Inc()
+	go p.watch(pc)
+	return pc, nil
+}
+
+// watch evicts a connection as soon as it reports an error or shuts down
+func (p *Pool) watch(pc *pooledConn) {
+	err, ok := <-pc.conn.ErrorChan()
+	if pc.broken.Swap(true) {
+		// We closed the connection ourselves
+		return
+	}
+	if ok {
+		logging.GetLogger().Warn(
+			"evicting pooled node connection",
+			"error", err,
+		)
+	}
+	metricPoolEvictions.Inc()
+	// Remove the connection from the idle list. Leased connections are
+	// closed when their lease is released.
+	p.mu.Lock()
+	for idx, idlePc := range p.idle {
+		if idlePc == pc {
+			p.idle = append(p.idle[:idx], p.idle[idx+1:]...)
+			p.mu.Unlock()
+			pc.close()
+			return
+		}
+	}
+	p.mu.Unlock()
+}
+
+func (p *Pool) put(pc *pooledConn) {
+	p.mu.Lock()
+	if p.closed || pc.broken.Load() {
+		p.mu.Unlock()
+		pc.close()
+		return
+	}
+	p.idle = append(p.idle, pc)
+	p.mu.Unlock()
+}
+
+func (pc *pooledConn) close() {
+	pc.closeOnce.Do(func() {
+		pc.broken.Store(true)
+		pc.conn.Close()
+		metricPoolOpen.Dec()
+	})
+}
+
+// Lease represents exclusive use of a pooled connection
+type Lease struct {
+	pool             *Pool
+	pc               *pooledConn
+	releaseOnce      sync.Once
+	usedStateQuery   bool
+	usedTxMonitor    bool
+	discardOnRelease bool
+}
+
+// Connection returns the underlying Ouroboros connection. Callers should
+// prefer the protocol accessors below, which keep track of the mini-protocols
+// that need to be reset before the connection is reused.
+func (l *Lease) Connection() *ouroboros.Connection {
+	return l.pc.conn
+}
+
+// ChainSync returns the chain-sync client for the leased connection. It is
+// only suitable for one-shot calls such as GetCurrentTip, since pooled
+// connections have no chain-sync event channel.
+func (l *Lease) ChainSync() *chainsync.Client {
+	return l.pc.conn.ChainSync().Client
+}
+
+// LocalStateQuery returns the started local-state-query client for the leased
+// connection
+func (l *Lease) LocalStateQuery() *localstatequery.Client {
+	client := l.pc.conn.LocalStateQuery().Client
+	client.Start()
+	l.usedStateQuery = true
+	return client
+}
+
+// LocalTxMonitor returns the started local-tx-monitor client for the leased
+// connection
+func (l *Lease) LocalTxMonitor() *localtxmonitor.Client {
+	client := l.pc.conn.LocalTxMonitor().Client
+	client.Start()
+	l.usedTxMonitor = true
+	return client
+}
+
+// LocalTxSubmission returns the started local-tx-submission client for the
+// leased connection
+func (l *Lease) LocalTxSubmission() *localtxsubmission.Client {
+	client := l.pc.conn.LocalTxSubmission().Client
+	client.Start()
+	return client
+}
+
+// Release returns the connection to the pool. Any acquired ledger state or
+// mempool snapshot is released first so the next lease sees fresh data.
+func (l *Lease) Release() {
+	l.releaseOnce.Do(func() {
+		defer func() {
+			metricPoolInUse.Dec()
+			<-l.pool.slots
+		}()
+		if l.discardOnRelease || l.pc.broken.Load() {
+			l.pc.close()
+			return
+		}
+		if err := l.reset(); err != nil {
+			logging.GetLogger().Debug(
+				"closing pooled node connection after failed reset",
+				"error", err,
+			)
+			l.pc.close()
+			return
+		}
+		l.pool.put(l.pc)
+	})
+}
+
+// Discard closes the leased connection instead of returning it to the pool.
+// This should be used when the connection is left in an unknown state.
+func (l *Lease) Discard() {
+	l.discardOnRelease = true
+	l.Release()
+}
+
+func (l *Lease) reset() error {
+	// Only protocols which are still holding an acquired state need to be
+	// released, as sending a release from the idle state is a protocol
+	// violation
+	if l.usedStateQuery {
+		client := l.pc.conn.LocalStateQuery().Client
+		if !client.IsInTerminalOrIdleState() {
+			if err := client.Release(); err != nil {
+				return fmt.Errorf("release local-state-query: %w", err)
+			}
+		}
+	}
+	if l.usedTxMonitor {
+		client := l.pc.conn.LocalTxMonitor().Client
+		if !client.IsInTerminalOrIdleState() {
+			if err := client.Release(); err != nil {
+				return fmt.Errorf("release local-tx-monitor: %w", err)
+			}
+		}
+	}
+	return nil
+}
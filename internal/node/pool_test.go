@@ -0,0 +1,136 @@
+// Copyright 2025 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	ouroboros "github.com/blinklabs-io/gouroboros"
+)
+
+func testPool(t *testing.T, size int, waitTimeout time.Duration) (*Pool, *int) {
+	t.Helper()
+
+	dialCount := 0
+	pool := NewPool(
+		size,
+		waitTimeout,
+		func() (*ouroboros.Connection, *Upstream, error) {
+			dialCount++
+			conn, err := ouroboros.NewConnection()
+			return conn, nil, err
+		},
+	)
+	t.Cleanup(pool.Close)
+	return pool, &dialCount
+}
+
+func TestPoolReusesReleasedConnection(t *testing.T) {
+	pool, dialCount := testPool(t, 1, time.Second)
+
+	lease, err := pool.Acquire(context.Background())
+	if err != nil {
+		t.Fatalf("Acquire() error = %v", err)
+	}
+	conn := lease.Connection()
+	lease.Release()
+
+	lease, err = pool.Acquire(context.Background())
+	if err != nil {
+		t.Fatalf("Acquire() error = %v", err)
+	}
+	defer lease.Release()
+	if lease.Connection() != conn {
+		t.Fatal("expected released connection to be reused")
+	}
+	if *dialCount != 1 {
+		t.Fatalf("dial count = %d, want 1", *dialCount)
+	}
+}
+
+func TestPoolAcquireTimesOutWhenExhausted(t *testing.T) {
+	pool, _ := testPool(t, 1, 10*time.Millisecond)
+
+	lease, err := pool.Acquire(context.Background())
+	if err != nil {
+		t.Fatalf("Acquire() error = %v", err)
+	}
+	defer lease.Release()
+
+	_, err = pool.Acquire(context.Background())
+	if !errors.Is(err, ErrPoolWaitTimeout) {
+		t.Fatalf("Acquire() error = %v, want %v", err, ErrPoolWaitTimeout)
+	}
+}
+
+func TestPoolDiscardDialsNewConnection(t *testing.T) {
+	pool, dialCount := testPool(t, 1, time.Second)
+
+	lease, err := pool.Acquire(context.Background())
+	if err != nil {
+		t.Fatalf("Acquire() error = %v", err)
+	}
+	conn := lease.Connection()
+	lease.Discard()
+
+	lease, err = pool.Acquire(context.Background())
+	if err != nil {
+		t.Fatalf("Acquire() error = %v", err)
+	}
+	defer lease.Release()
+	if lease.Connection() == conn {
+		t.Fatal("expected discarded connection not to be reused")
+	}
+	if *dialCount != 2 {
+		t.Fatalf("dial count = %d, want 2", *dialCount)
+	}
+}
+
+func TestPoolEvictsConnectionOnError(t *testing.T) {
+	pool, dialCount := testPool(t, 1, time.Second)
+
+	lease, err := pool.Acquire(context.Background())
+	if err != nil {
+		t.Fatalf("Acquire() error = %v", err)
+	}
+	conn := lease.Connection()
+	pc := lease.pc
+	lease.Release()
+
+	// Simulate an asynchronous connection failure
+	conn.ErrorChan() <- errors.New("connection reset")
+	deadline := time.Now().Add(time.Second)
+	for !pc.broken.Load() {
+		if time.Now().After(deadline) {
+			t.Fatal("timed out waiting for connection eviction")
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	lease, err = pool.Acquire(context.Background())
+	if err != nil {
+		t.Fatalf("Acquire() error = %v", err)
+	}
+	defer lease.Release()
+	if lease.Connection() == conn {
+		t.Fatal("expected evicted connection not to be reused")
+	}
+	if *dialCount != 2 {
+		t.Fatalf("dial count = %d, want 2", *dialCount)
+	}
+}
@@ -0,0 +1,54 @@
+// Copyright 2025 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/blinklabs-io/gouroboros/ledger"
+	lcommon "github.com/blinklabs-io/gouroboros/ledger/common"
+)
+
+// ParsePoolId parses a stake pool ID in bech32 (pool1...) or hex form
+func ParsePoolId(poolId string) (ledger.PoolId, error) {
+	if strings.HasPrefix(poolId, "pool1") {
+		ret, err := lcommon.NewPoolIdFromBech32(poolId)
+		if err != nil {
+			return ledger.PoolId{}, fmt.Errorf(
+				"invalid pool ID %q: %w",
+				poolId,
+				err,
+			)
+		}
+		return ret, nil
+	}
+	poolIdBytes, err := hex.DecodeString(poolId)
+	if err != nil || len(poolIdBytes) != lcommon.Blake2b224Size {
+		return ledger.PoolId{}, fmt.Errorf("invalid pool ID %q", poolId)
+	}
+	return ledger.PoolId(poolIdBytes), nil
+}
+
+// SortPoolIds sorts the provided pool IDs in byte order and returns them
+func SortPoolIds[T ~[28]byte](poolIds []T) []T {
+	slices.SortFunc(poolIds, func(a, b T) int {
+		return bytes.Compare(a[:], b[:])
+	})
+	return poolIds
+}
@@ -0,0 +1,62 @@
+// Copyright 2025 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/blinklabs-io/gouroboros/ledger"
+)
+
+func TestParsePoolId(t *testing.T) {
+	var poolId ledger.PoolId
+	poolId[0] = 0x0f
+	poolId[27] = 0xa0
+	for _, input := range []string{
+		poolId.String(),
+		hex.EncodeToString(poolId[:]),
+	} {
+		got, err := ParsePoolId(input)
+		if err != nil {
+			t.Errorf("unexpected error for input %q: %s", input, err)
+			continue
+		}
+		if got != poolId {
+			t.Errorf("unexpected pool ID for input %q: %s", input, got)
+		}
+	}
+	for _, input := range []string{
+		"",
+		"pool1invalid",
+		hex.EncodeToString(poolId[:27]),
+		"zz",
+	} {
+		if _, err := ParsePoolId(input); err == nil {
+			t.Errorf("expected error for input %q", input)
+		}
+	}
+}
+
+func TestSortPoolIds(t *testing.T) {
+	poolIds := []ledger.PoolId{{2}, {0, 5}, {1}, {0, 1}}
+	SortPoolIds(poolIds)
+	expected := []ledger.PoolId{{0, 1}, {0, 5}, {1}, {2}}
+	for i := range expected {
+		if poolIds[i] != expected[i] {
+			t.Fatalf("unexpected order: %v", poolIds)
+		}
+	}
+}
@@ -0,0 +1,310 @@
+// Copyright 2025 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/blinklabs-io/gouroboros/protocol/localstatequery"
+)
+
+// SystemStartToUnixMs converts SystemStartResult to Unix milliseconds
+func SystemStartToUnixMs(ss *localstatequery.SystemStartResult) int64 {
+	// SystemStart contains: Year, Day (day of year 1-366), Picoseconds (within day)
+	// Convert to Unix timestamp in milliseconds
+	year := int(ss.Year.Int64())
+	dayOfYear := ss.Day
+	picoseconds := ss.Picoseconds.Int64()
+
+	// Create time for January 1st of the year
+	t := time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+	// Add days (dayOfYear is 1-indexed, so subtract 1)
+	t = t.AddDate(0, 0, dayOfYear-1)
+	// Add picoseconds (convert to nanoseconds first: pico = 10^-12, nano = 10^-9)
+	nanoseconds := picoseconds / 1000
+	t = t.Add(time.Duration(nanoseconds))
+
+	return t.UnixMilli()
+}
+
+func slotDurationMs(slotCount uint64, slotLengthMs int64) (int64, error) {
+	if slotCount > uint64(math.MaxInt64) {
+		return 0, fmt.Errorf("slot span %d exceeds int64 range", slotCount)
+	}
+	slotCountInt := int64(
+		slotCount,
+	) //nolint:gosec // checked against MaxInt64 above
+	if slotCountInt > math.MaxInt64/slotLengthMs {
+		return 0, fmt.Errorf(
+			"slot span %d overflows milliseconds with slot length %d",
+			slotCount,
+			slotLengthMs,
+		)
+	}
+	return slotCountInt * slotLengthMs, nil
+}
+
+func addMilliseconds(base int64, delta int64) (int64, error) {
+	if delta > 0 && base > math.MaxInt64-delta {
+		return 0, errors.New("POSIX time exceeds int64 range")
+	}
+	if delta < 0 && base < math.MinInt64-delta {
+		return 0, errors.New("POSIX time is below int64 range")
+	}
+	return base + delta, nil
+}
+
+// QueryEraHistory fetches the system start, in milliseconds since the Unix
+// epoch, and the era history from the node
+func QueryEraHistory(
+	client *localstatequery.Client,
+) (int64, []localstatequery.EraHistoryResult, error) {
+	systemStart, err := client.GetSystemStart()
+	if err != nil {
+		return 0, nil, fmt.Errorf("get system start: %w", err)
+	}
+	eraHistory, err := client.GetEraHistory()
+	if err != nil {
+		return 0, nil, fmt.Errorf("get era history: %w", err)
+	}
+	return SystemStartToUnixMs(systemStart), eraHistory, nil
+}
+
+// EraBound is the start or end of an era in the hard-fork era history
+type EraBound struct {
+	Slot  uint64
+	Epoch uint64
+	// POSIXTime is the wall-clock time of the bound in milliseconds since
+	// the Unix epoch
+	POSIXTime int64
+}
+
+// EraSummary describes a single era from the hard-fork era history
+type EraSummary struct {
+	// EraId is the position of the era in the era history, which matches
+	// the ledger era ID
+	EraId uint8
+	Begin EraBound
+	// End is nil when the era has no known end
+	End          *EraBound
+	EpochLength  uint64
+	SlotLengthMs int64
+	SafeZone     uint64
+}
+
+// EraSummaries converts the era history from the node into era summaries with
+// absolute times, ordered by start slot
+func EraSummaries(
+	systemStartMs int64,
+	eraHistory []localstatequery.EraHistoryResult,
+) ([]EraSummary, error) {
+	if len(eraHistory) == 0 {
+		return nil, errors.New("era history is empty")
+	}
+	ret := make([]EraSummary, 0, len(eraHistory))
+	for idx, era := range eraHistory {
+		if idx > math.MaxUint8 {
+			return nil, fmt.Errorf(
+				"era history contains too many eras: %d",
+				len(eraHistory),
+			)
+		}
+		if era.Begin.SlotNo < 0 || era.End.SlotNo < 0 {
+			return nil, fmt.Errorf(
+				"era history contains negative slot boundary: begin=%d end=%d",
+				era.Begin.SlotNo,
+				era.End.SlotNo,
+			)
+		}
+		if era.Begin.EpochNo < 0 || era.End.EpochNo < 0 {
+			return nil, fmt.Errorf(
+				"era history contains negative epoch boundary: begin=%d end=%d",
+				era.Begin.EpochNo,
+				era.End.EpochNo,
+			)
+		}
+		if era.Params.SlotLength <= 0 {
+			return nil, fmt.Errorf(
+				"era history contains invalid slot length %d",
+				era.Params.SlotLength,
+			)
+		}
+		// The hard-fork era history reports SlotLength already in
+		// milliseconds (Ouroboros encodes it via slotLengthToMillisec), so it
+		// must not be scaled by 1000 again.
+		summary := EraSummary{
+			EraId: uint8(idx), //nolint:gosec // checked against MaxUint8 above
+			Begin: EraBound{
+				Slot:  uint64(era.Begin.SlotNo),  //nolint:gosec
+				Epoch: uint64(era.Begin.EpochNo), //nolint:gosec
+			},
+			EpochLength:  uint64(max(era.Params.EpochLength, 0)), //nolint:gosec
+			SlotLengthMs: int64(era.Params.SlotLength),
+			SafeZone:     uint64(max(era.Params.Unknown, 0)), //nolint:gosec
+		}
+		// Only the last era is open-ended. Earlier eras can have no length,
+		// such as the eras which a testnet skips by forking at slot 0.
+		endSlot := uint64(era.End.SlotNo) //nolint:gosec
+		if idx < len(eraHistory)-1 && endSlot < summary.Begin.Slot {
+			return nil, fmt.Errorf(
+				"era starting at slot %d ends before it begins at slot %d",
+				summary.Begin.Slot,
+				endSlot,
+			)
+		}
+		if endSlot > summary.Begin.Slot || idx < len(eraHistory)-1 {
+			summary.End = &EraBound{
+				Slot:  endSlot,
+				Epoch: uint64(era.End.EpochNo), //nolint:gosec
+			}
+		}
+		ret = append(ret, summary)
+	}
+	sort.SliceStable(ret, func(i, j int) bool {
+		return ret[i].Begin.Slot < ret[j].Begin.Slot
+	})
+	// Each era starts where the previous one ended, so the absolute times are
+	// accumulated from the system start
+	elapsedMs := systemStartMs
+	for idx := range ret {
+		era := &ret[idx]
+		era.Begin.POSIXTime = elapsedMs
+		if era.End == nil {
+			if idx != len(ret)-1 {
+				return nil, fmt.Errorf(
+					"era starting at slot %d has no end but is not the last era",
+					era.Begin.Slot,
+				)
+			}
+			break
+		}
+		eraDurationMs, err := slotDurationMs(
+			era.End.Slot-era.Begin.Slot,
+			era.SlotLengthMs,
+		)
+		if err != nil {
+			return nil, err
+		}
+		elapsedMs, err = addMilliseconds(elapsedMs, eraDurationMs)
+		if err != nil {
+			return nil, err
+		}
+		era.End.POSIXTime = elapsedMs
+	}
+	return ret, nil
+}
+
+// eraForSlot returns the era summary containing the provided slot
+func eraForSlot(eras []EraSummary, slot uint64) (*EraSummary, error) {
+	if slot < eras[0].Begin.Slot {
+		return nil, fmt.Errorf(
+			"slot %d is before first era boundary %d",
+			slot,
+			eras[0].Begin.Slot,
+		)
+	}
+	for idx := range eras {
+		era := &eras[idx]
+		if era.End == nil || slot < era.End.Slot {
+			return era, nil
+		}
+	}
+	return nil, fmt.Errorf("slot %d is outside era history", slot)
+}
+
+// SlotToPOSIXTime converts a slot number to POSIXTime (milliseconds since Unix epoch)
+func SlotToPOSIXTime(
+	slot uint64,
+	systemStartMs int64,
+	eraHistory []localstatequery.EraHistoryResult,
+) (int64, error) {
+	eras, err := EraSummaries(systemStartMs, eraHistory)
+	if err != nil {
+		return 0, err
+	}
+	era, err := eraForSlot(eras, slot)
+	if err != nil {
+		return 0, err
+	}
+	deltaMs, err := slotDurationMs(slot-era.Begin.Slot, era.SlotLengthMs)
+	if err != nil {
+		return 0, err
+	}
+	return addMilliseconds(era.Begin.POSIXTime, deltaMs)
+}
+
+// POSIXTimeToSlot converts a POSIXTime (milliseconds since Unix epoch) to the
+// slot which contains it
+func POSIXTimeToSlot(
+	posixTime int64,
+	systemStartMs int64,
+	eraHistory []localstatequery.EraHistoryResult,
+) (uint64, error) {
+	eras, err := EraSummaries(systemStartMs, eraHistory)
+	if err != nil {
+		return 0, err
+	}
+	if posixTime < eras[0].Begin.POSIXTime {
+		return 0, fmt.Errorf(
+			"POSIX time %d is before system start %d",
+			posixTime,
+			eras[0].Begin.POSIXTime,
+		)
+	}
+	for _, era := range eras {
+		if era.End != nil && posixTime >= era.End.POSIXTime {
+			continue
+		}
+		// posixTime is not before the start of the era, so this is positive
+		slotCount := uint64( //nolint:gosec
+			(posixTime - era.Begin.POSIXTime) / era.SlotLengthMs,
+		)
+		if math.MaxUint64-slotCount < era.Begin.Slot {
+			return 0, fmt.Errorf("POSIX time %d exceeds slot range", posixTime)
+		}
+		return era.Begin.Slot + slotCount, nil
+	}
+	return 0, fmt.Errorf("POSIX time %d is outside era history", posixTime)
+}
+
+// SlotToEpoch returns the epoch containing the provided slot and the slot's
+// offset within that epoch
+func SlotToEpoch(
+	slot uint64,
+	eraHistory []localstatequery.EraHistoryResult,
+) (uint64, uint64, error) {
+	// The system start does not affect epoch boundaries
+	eras, err := EraSummaries(0, eraHistory)
+	if err != nil {
+		return 0, 0, err
+	}
+	era, err := eraForSlot(eras, slot)
+	if err != nil {
+		return 0, 0, err
+	}
+	if era.EpochLength == 0 {
+		return 0, 0, fmt.Errorf(
+			"era starting at slot %d has invalid epoch length 0",
+			era.Begin.Slot,
+		)
+	}
+	slotsIntoEra := slot - era.Begin.Slot
+	epoch := era.Begin.Epoch + slotsIntoEra/era.EpochLength
+	return epoch, slotsIntoEra % era.EpochLength, nil
+}
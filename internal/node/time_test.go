@@ -0,0 +1,268 @@
+// Copyright 2025 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"testing"
+
+	"github.com/blinklabs-io/gouroboros/protocol/localstatequery"
+)
+
+// TestSlotToPOSIXTimeUsesMillisecondSlotLength guards against double-scaling
+// the slot length. The hard-fork era history query already reports SlotLength
+// in milliseconds, so SlotToPOSIXTime must not multiply it by 1000 again.
+func TestSlotToPOSIXTimeUsesMillisecondSlotLength(t *testing.T) {
+	const systemStartMs = int64(1_000_000)
+	const slotLengthMs = 1000 // Shelley: 1 second per slot, reported as 1000 ms
+	const slot = 100
+
+	var era localstatequery.EraHistoryResult
+	era.Begin.SlotNo = 0
+	era.End.SlotNo = 0 // open-ended final era
+	era.Params.EpochLength = 432000
+	era.Params.SlotLength = slotLengthMs
+
+	got, err := SlotToPOSIXTime(
+		slot,
+		systemStartMs,
+		[]localstatequery.EraHistoryResult{era},
+	)
+	if err != nil {
+		t.Fatalf("SlotToPOSIXTime() error = %v", err)
+	}
+	want := systemStartMs + int64(slot)*int64(slotLengthMs)
+	if got != want {
+		t.Fatalf("SlotToPOSIXTime() = %d, want %d", got, want)
+	}
+}
+
+// testEraHistory returns a Byron era of 20 second slots followed by an
+// open-ended Shelley era of 1 second slots, similar to mainnet
+func testEraHistory() []localstatequery.EraHistoryResult {
+	var byron localstatequery.EraHistoryResult
+	byron.Begin.SlotNo = 0
+	byron.Begin.EpochNo = 0
+	byron.End.SlotNo = 43200
+	byron.End.EpochNo = 2
+	byron.Params.EpochLength = 21600
+	byron.Params.SlotLength = 20000
+	byron.Params.Unknown = 4320
+
+	var shelley localstatequery.EraHistoryResult
+	shelley.Begin.SlotNo = 43200
+	shelley.Begin.EpochNo = 2
+	shelley.Params.EpochLength = 432000
+	shelley.Params.SlotLength = 1000
+	shelley.Params.Unknown = 129600
+
+	return []localstatequery.EraHistoryResult{byron, shelley}
+}
+
+func TestEraSummaries(t *testing.T) {
+	const systemStartMs = int64(1_000_000)
+	eras, err := EraSummaries(systemStartMs, testEraHistory())
+	if err != nil {
+		t.Fatalf("EraSummaries() error = %v", err)
+	}
+	if len(eras) != 2 {
+		t.Fatalf("expected 2 eras, got %d", len(eras))
+	}
+	byronEndMs := systemStartMs + 43200*20000
+	if eras[0].End == nil || eras[0].End.POSIXTime != byronEndMs {
+		t.Fatalf("unexpected Byron end: %+v", eras[0].End)
+	}
+	if eras[1].Begin.POSIXTime != byronEndMs {
+		t.Fatalf(
+			"Shelley begins at %d, want %d",
+			eras[1].Begin.POSIXTime,
+			byronEndMs,
+		)
+	}
+	if eras[1].End != nil {
+		t.Fatalf("expected open-ended Shelley era, got %+v", eras[1].End)
+	}
+	if eras[1].EraId != 1 || eras[1].SafeZone != 129600 {
+		t.Fatalf("unexpected Shelley summary: %+v", eras[1])
+	}
+}
+
+// testPreviewEraHistory returns the preview testnet layout, which forks from
+// Byron to Alonzo at slot 0, leaving the earlier eras with no length
+func testPreviewEraHistory() []localstatequery.EraHistoryResult {
+	eraHistory := make([]localstatequery.EraHistoryResult, 7)
+	for idx := range eraHistory {
+		eraHistory[idx].Params.EpochLength = 86400
+		eraHistory[idx].Params.SlotLength = 1000
+		eraHistory[idx].Params.Unknown = 25920
+	}
+	// Alonzo
+	eraHistory[4].End.SlotNo = 259200
+	eraHistory[4].End.EpochNo = 3
+	// Babbage
+	eraHistory[5].Begin = eraHistory[4].End
+	eraHistory[5].End.SlotNo = 55814400
+	eraHistory[5].End.EpochNo = 646
+	// Conway
+	eraHistory[6].Begin = eraHistory[5].End
+	return eraHistory
+}
+
+func TestEraSummariesZeroLengthEras(t *testing.T) {
+	const systemStartMs = int64(1_666_656_000_000)
+	eras, err := EraSummaries(systemStartMs, testPreviewEraHistory())
+	if err != nil {
+		t.Fatalf("EraSummaries() error = %v", err)
+	}
+	if len(eras) != 7 {
+		t.Fatalf("expected 7 eras, got %d", len(eras))
+	}
+	for idx, era := range eras[:4] {
+		if era.End == nil || era.End.Slot != 0 ||
+			era.End.POSIXTime != systemStartMs {
+			t.Fatalf("unexpected end for era %d: %+v", idx, era.End)
+		}
+	}
+	if eras[6].End != nil {
+		t.Fatalf("expected open-ended Conway era, got %+v", eras[6].End)
+	}
+	testDefs := []struct {
+		slot  uint64
+		eraId uint8
+	}{
+		{slot: 0, eraId: 4},
+		{slot: 259199, eraId: 4},
+		{slot: 259200, eraId: 5},
+		{slot: 60000000, eraId: 6},
+	}
+	for _, testDef := range testDefs {
+		era, err := eraForSlot(eras, testDef.slot)
+		if err != nil {
+			t.Fatalf("eraForSlot(%d) error = %v", testDef.slot, err)
+		}
+		if era.EraId != testDef.eraId {
+			t.Fatalf(
+				"slot %d is in era %d, want %d",
+				testDef.slot,
+				era.EraId,
+				testDef.eraId,
+			)
+		}
+	}
+	got, err := SlotToPOSIXTime(
+		60000000,
+		systemStartMs,
+		testPreviewEraHistory(),
+	)
+	if err != nil {
+		t.Fatalf("SlotToPOSIXTime() error = %v", err)
+	}
+	if want := systemStartMs + 60000000*1000; got != want {
+		t.Fatalf("SlotToPOSIXTime() = %d, want %d", got, want)
+	}
+}
+
+func TestPOSIXTimeToSlot(t *testing.T) {
+	const systemStartMs = int64(1_000_000)
+	eraHistory := testEraHistory()
+	testDefs := []struct {
+		posixTime int64
+		slot      uint64
+	}{
+		{posixTime: systemStartMs, slot: 0},
+		{posixTime: systemStartMs + 19999, slot: 0},
+		{posixTime: systemStartMs + 20000, slot: 1},
+		{posixTime: systemStartMs + 43200*20000, slot: 43200},
+		{posixTime: systemStartMs + 43200*20000 + 1500, slot: 43201},
+	}
+	for _, testDef := range testDefs {
+		slot, err := POSIXTimeToSlot(
+			testDef.posixTime,
+			systemStartMs,
+			eraHistory,
+		)
+		if err != nil {
+			t.Fatalf(
+				"POSIXTimeToSlot(%d) error = %v",
+				testDef.posixTime,
+				err,
+			)
+		}
+		if slot != testDef.slot {
+			t.Fatalf(
+				"POSIXTimeToSlot(%d) = %d, want %d",
+				testDef.posixTime,
+				slot,
+				testDef.slot,
+			)
+		}
+		// The slot must start no later than the requested time
+		slotStart, err := SlotToPOSIXTime(slot, systemStartMs, eraHistory)
+		if err != nil {
+			t.Fatalf("SlotToPOSIXTime(%d) error = %v", slot, err)
+		}
+		if slotStart > testDef.posixTime {
+			t.Fatalf(
+				"slot %d starts at %d, after %d",
+				slot,
+				slotStart,
+				testDef.posixTime,
+			)
+		}
+	}
+	if _, err := POSIXTimeToSlot(
+		systemStartMs-1,
+		systemStartMs,
+		eraHistory,
+	); err == nil {
+		t.Fatalf("expected error for time before system start")
+	}
+}
+
+func TestPOSIXTimeToSlotBeyondEraHistory(t *testing.T) {
+	eraHistory := testEraHistory()[:1]
+	if _, err := POSIXTimeToSlot(43200*20000, 0, eraHistory); err == nil {
+		t.Fatalf("expected error for time beyond the era history")
+	}
+}
+
+func TestSlotToEpoch(t *testing.T) {
+	eraHistory := testEraHistory()
+	testDefs := []struct {
+		slot        uint64
+		epoch       uint64
+		slotInEpoch uint64
+	}{
+		{slot: 0, epoch: 0, slotInEpoch: 0},
+		{slot: 21601, epoch: 1, slotInEpoch: 1},
+		{slot: 43200, epoch: 2, slotInEpoch: 0},
+		{slot: 43200 + 432000 + 5, epoch: 3, slotInEpoch: 5},
+	}
+	for _, testDef := range testDefs {
+		epoch, slotInEpoch, err := SlotToEpoch(testDef.slot, eraHistory)
+		if err != nil {
+			t.Fatalf("SlotToEpoch(%d) error = %v", testDef.slot, err)
+		}
+		if epoch != testDef.epoch || slotInEpoch != testDef.slotInEpoch {
+			t.Fatalf(
+				"SlotToEpoch(%d) = (%d, %d), want (%d, %d)",
+				testDef.slot,
+				epoch,
+				slotInEpoch,
+				testDef.epoch,
+				testDef.slotInEpoch,
+			)
+		}
+	}
+}
@@ -0,0 +1,190 @@
+// Copyright 2025 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"bytes"
+	"slices"
+	"sync"
+
+	"github.com/blinklabs-io/gouroboros/ledger"
+	ocommon "github.com/blinklabs-io/gouroboros/protocol/common"
+)
+
+const (
+	// Number of recently seen transactions kept for lookups by hash
+	txIndexSize = 50000
+	// Number of recently seen datums kept for lookups by hash
+	datumIndexSize = 50000
+)
+
+// IndexedTx is a transaction seen in a block followed by the chain indexer
+type IndexedTx struct {
+	// Type is the ledger transaction type, for decoding Cbor
+	Type        uint
+	Cbor        []byte
+	Point       ocommon.Point
+	BlockNumber uint64
+}
+
+// Transaction decodes the indexed transaction
+func (t IndexedTx) Transaction() (ledger.Transaction, error) {
+	return ledger.NewTransactionFromCbor(t.Type, t.Cbor)
+}
+
+// fifoMap is a map which drops its oldest entries beyond a fixed size
+type fifoMap[K comparable, V any] struct {
+	size  int
+	items map[K]V
+	order []K
+}
+
+func newFifoMap[K comparable, V any](size int) *fifoMap[K, V] {
+	return &fifoMap[K, V]{
+		size:  size,
+		items: make(map[K]V),
+	}
+}
+
+func (m *fifoMap[K, V]) set(key K, value V) {
+	if _, ok := m.items[key]; !ok {
+		m.order = append(m.order, key)
+	}
+	m.items[key] = value
+	if len(m.order) > m.size {
+		drop := len(m.order) - m.size
+		for _, key := range m.order[:drop] {
+			delete(m.items, key)
+		}
+		m.order = append(m.order[:0], m.order[drop:]...)
+	}
+}
+
+func (m *fifoMap[K, V]) get(key K) (V, bool) {
+	ret, ok := m.items[key]
+	return ret, ok
+}
+
+// deleteFunc removes the entries for which fn returns true
+func (m *fifoMap[K, V]) deleteFunc(fn func(K, V) bool) {
+	m.order = slices.DeleteFunc(m.order, func(key K) bool {
+		if !fn(key, m.items[key]) {
+			return false
+		}
+		delete(m.items, key)
+		return true
+	})
+}
+
+// txIndex records the transactions and datums of blocks followed from the
+// tip by the chain indexer, so that they can be looked up by hash.
+// Transactions are removed for rollbacks. A transaction seen again in a later
+// block is updated to point at that block. Datums are kept on rollback, as
+// they're looked up by the hash of their content.
+type txIndex struct {
+	mu     sync.Mutex
+	txs    *fifoMap[ledger.Blake2b256, IndexedTx]
+	datums *fifoMap[ledger.Blake2b256, []byte]
+}
+
+var globalTxIndex = newTxIndex(txIndexSize, datumIndexSize)
+
+func newTxIndex(txSize int, datumSize int) *txIndex {
+	return &txIndex{
+		txs:    newFifoMap[ledger.Blake2b256, IndexedTx](txSize),
+		datums: newFifoMap[ledger.Blake2b256, []byte](datumSize),
+	}
+}
+
+func (i *txIndex) addBlock(block ledger.Block) {
+	i.add(
+		ocommon.NewPoint(block.SlotNumber(), block.Hash().Bytes()),
+		block.BlockNumber(),
+		block.Transactions(),
+	)
+}
+
+func (i *txIndex) add(
+	point ocommon.Point,
+	blockNumber uint64,
+	txs []ledger.Transaction,
+) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	for _, tx := range txs {
+		hash := tx.Hash()
+		// Every chain-sync client receives the same blocks, so skip
+		// re-encoding transactions which we've already recorded
+		if prev, ok := i.txs.get(hash); ok &&
+			prev.Point.Slot == point.Slot &&
+			bytes.Equal(prev.Point.Hash, point.Hash) {
+			continue
+		}
+		// The CBOR is copied so that the index doesn't keep whole blocks in
+		// memory
+		i.txs.set(
+			hash,
+			IndexedTx{
+				Type:        uint(tx.Type()), // #nosec G115
+				Cbor:        bytes.Clone(tx.Cbor()),
+				Point:       point,
+				BlockNumber: blockNumber,
+			},
+		)
+		if witnesses := tx.Witnesses(); witnesses != nil {
+			for _, datum := range witnesses.PlutusData() {
+				i.datums.set(datum.Hash(), bytes.Clone(datum.Cbor()))
+			}
+		}
+		for _, output := range tx.Outputs() {
+			if datum := output.Datum(); datum != nil && datum.Cbor() != nil {
+				i.datums.set(datum.Hash(), bytes.Clone(datum.Cbor()))
+			}
+		}
+	}
+}
+
+// rollback removes the transactions of blocks after the provided slot
+func (i *txIndex) rollback(slot uint64) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.txs.deleteFunc(func(_ ledger.Blake2b256, tx IndexedTx) bool {
+		return tx.Point.Slot > slot
+	})
+}
+
+func (i *txIndex) tx(hash ledger.Blake2b256) (IndexedTx, bool) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	return i.txs.get(hash)
+}
+
+func (i *txIndex) datum(hash ledger.Blake2b256) ([]byte, bool) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	return i.datums.get(hash)
+}
+
+// LookupTx returns a recently seen transaction by hash, along with the block
+// which included it
+func LookupTx(hash ledger.Blake2b256) (IndexedTx, bool) {
+	return globalTxIndex.tx(hash)
+}
+
+// LookupDatum returns the CBOR of a recently seen datum by hash. Datums are
+// collected from transaction witness sets and inline output datums.
+func LookupDatum(hash ledger.Blake2b256) ([]byte, bool) {
+	return globalTxIndex.datum(hash)
+}
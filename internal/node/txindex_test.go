@@ -0,0 +1,146 @@
+// Copyright 2025 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/blinklabs-io/gouroboros/cbor"
+	"github.com/blinklabs-io/gouroboros/ledger"
+	lcommon "github.com/blinklabs-io/gouroboros/ledger/common"
+	"github.com/blinklabs-io/gouroboros/ledger/conway"
+	"github.com/blinklabs-io/gouroboros/ledger/shelley"
+	ocommon "github.com/blinklabs-io/gouroboros/protocol/common"
+	"github.com/blinklabs-io/plutigo/data"
+)
+
+// testIndexTx returns a decoded transaction which spends the provided output
+// index and carries the provided datum in its witness set
+func testIndexTx(
+	t *testing.T,
+	inputIndex uint32,
+	datum int64,
+) ledger.Transaction {
+	t.Helper()
+	tx := &conway.ConwayTransaction{
+		Body: conway.ConwayTransactionBody{
+			TxInputs: conway.NewConwayTransactionInputSet(
+				[]shelley.ShelleyTransactionInput{
+					{OutputIndex: inputIndex},
+				},
+			),
+		},
+		WitnessSet: conway.ConwayTransactionWitnessSet{
+			WsPlutusData: cbor.NewSetType(
+				[]lcommon.Datum{
+					{Data: data.NewInteger(big.NewInt(datum))},
+				},
+				false,
+			),
+		},
+		TxIsValid: true,
+	}
+	txCbor, err := cbor.Encode(tx)
+	if err != nil {
+		t.Fatalf("unexpected error encoding transaction: %s", err)
+	}
+	ret, err := conway.NewConwayTransactionFromCbor(txCbor)
+	if err != nil {
+		t.Fatalf("unexpected error decoding transaction: %s", err)
+	}
+	return ret
+}
+
+func TestTxIndex(t *testing.T) {
+	index := newTxIndex(2, 2)
+	txs := []ledger.Transaction{
+		testIndexTx(t, 0, 1),
+		testIndexTx(t, 1, 2),
+		testIndexTx(t, 2, 3),
+	}
+	point := ocommon.NewPoint(10, []byte{0x01})
+	index.add(point, 5, txs[:2])
+
+	got, ok := index.tx(txs[1].Hash())
+	if !ok || got.Point.Slot != 10 || got.BlockNumber != 5 {
+		t.Fatalf("unexpected indexed transaction: %+v", got)
+	}
+	tx, err := got.Transaction()
+	if err != nil {
+		t.Fatalf("unexpected error decoding indexed transaction: %s", err)
+	}
+	if tx.Hash() != txs[1].Hash() {
+		t.Fatalf("unexpected transaction hash: %s", tx.Hash())
+	}
+
+	datum := txs[1].Witnesses().PlutusData()[0]
+	datumCbor, ok := index.datum(datum.Hash())
+	if !ok || !bytes.Equal(datumCbor, datum.Cbor()) {
+		t.Fatalf("unexpected datum: %x", datumCbor)
+	}
+
+	// A transaction seen again in a later block points at that block
+	index.add(ocommon.NewPoint(20, []byte{0x02}), 6, txs[1:2])
+	if got, _ := index.tx(txs[1].Hash()); got.Point.Slot != 20 {
+		t.Fatalf("unexpected indexed transaction: %+v", got)
+	}
+
+	// The oldest entries are dropped once the index is full
+	index.add(ocommon.NewPoint(30, []byte{0x03}), 7, txs[2:])
+	if _, ok := index.tx(txs[0].Hash()); ok {
+		t.Fatalf("unexpected indexed transaction: %s", txs[0].Hash())
+	}
+	if _, ok := index.tx(txs[2].Hash()); !ok {
+		t.Fatalf("missing indexed transaction: %s", txs[2].Hash())
+	}
+	oldDatum := txs[0].Witnesses().PlutusData()[0]
+	if _, ok := index.datum(oldDatum.Hash()); ok {
+		t.Fatalf("unexpected datum: %s", oldDatum.Hash())
+	}
+}
+
+func TestTxIndexRollback(t *testing.T) {
+	index := newTxIndex(10, 10)
+	txs := []ledger.Transaction{
+		testIndexTx(t, 0, 1),
+		testIndexTx(t, 1, 2),
+	}
+	index.add(ocommon.NewPoint(10, []byte{0x01}), 5, txs[:1])
+	index.add(ocommon.NewPoint(20, []byte{0x02}), 6, txs[1:])
+
+	// Transactions in blocks after the rollback point are removed
+	index.rollback(10)
+	if _, ok := index.tx(txs[0].Hash()); !ok {
+		t.Fatalf("missing indexed transaction: %s", txs[0].Hash())
+	}
+	if _, ok := index.tx(txs[1].Hash()); ok {
+		t.Fatalf("unexpected indexed transaction: %s", txs[1].Hash())
+	}
+	datum := txs[1].Witnesses().PlutusData()[0]
+	if _, ok := index.datum(datum.Hash()); !ok {
+		t.Fatalf("missing datum: %s", datum.Hash())
+	}
+
+	// A transaction included again after the rollback is indexed again
+	index.add(ocommon.NewPoint(21, []byte{0x03}), 6, txs[1:])
+	if got, _ := index.tx(txs[1].Hash()); got.Point.Slot != 21 {
+		t.Fatalf("unexpected indexed transaction: %+v", got)
+	}
+	if len(index.txs.order) != 2 {
+		t.Fatalf("unexpected index order: %v", index.txs.order)
+	}
+}
@@ -0,0 +1,312 @@
+// Copyright 2025 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"reflect"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/blinklabs-io/gouroboros/cbor"
+	"github.com/blinklabs-io/gouroboros/ledger"
+	"github.com/blinklabs-io/gouroboros/protocol/localtxsubmission"
+)
+
+var errorType = reflect.TypeFor[error]()
+
+// TxRejectReason is the reason given by the node for rejecting a
+// transaction, decoded into a tree which follows the ledger's predicate
+// failures. Failures which wrap others list them, and the innermost failures
+// have a message. Both can have details, such as the era or the values which
+// broke the rule.
+type TxRejectReason struct {
+	Type     string           `json:"type"`
+	Message  string           `json:"message,omitempty"`
+	Details  map[string]any   `json:"details,omitempty"`
+	Failures []TxRejectReason `json:"failures,omitempty"`
+}
+
+// TxRejectReasonFromError returns the decoded reason for a transaction which
+// was rejected by the node, or false if the error isn't a rejection
+func TxRejectReasonFromError(err error) (*TxRejectReason, bool) {
+	rejectErr, ok := txRejectedError(err)
+	if !ok {
+		return nil, false
+	}
+	reason := rejectErr.Reason
+	if reason == nil {
+		var err error
+		reason, err = ledger.NewTxSubmitErrorFromCbor(rejectErr.ReasonCbor)
+		if err != nil {
+			return &TxRejectReason{
+				Type:    "Unknown",
+				Message: rejectErr.Error(),
+				Details: map[string]any{
+					"cbor": hex.EncodeToString(rejectErr.ReasonCbor),
+				},
+			}, true
+		}
+	}
+	ret := newTxRejectReason(reason)
+	return &ret, true
+}
+
+// TxRejectReasonCbor returns the raw reason for a transaction which was
+// rejected by the node, or false if the error isn't a rejection
+func TxRejectReasonCbor(err error) ([]byte, bool) {
+	rejectErr, ok := txRejectedError(err)
+	if !ok {
+		return nil, false
+	}
+	return rejectErr.ReasonCbor, true
+}
+
+// txRejectedError finds a rejection in the error chain. The client returns
+// it by value, but it may also be wrapped as a pointer.
+func txRejectedError(
+	err error,
+) (localtxsubmission.TransactionRejectedError, bool) {
+	var rejectErr localtxsubmission.TransactionRejectedError
+	if errors.As(err, &rejectErr) {
+		return rejectErr, true
+	}
+	var rejectErrPtr *localtxsubmission.TransactionRejectedError
+	if errors.As(err, &rejectErrPtr) && rejectErrPtr != nil {
+		return *rejectErrPtr, true
+	}
+	return rejectErr, false
+}
+
+// newTxRejectReason converts a rejection reason decoded by gouroboros. The
+// type is the name of the Go error type, nested errors become nested
+// failures, and the other exported fields become details.
+func newTxRejectReason(err error) TxRejectReason {
+	val := reflect.ValueOf(err)
+	for val.Kind() == reflect.Pointer && !val.IsNil() {
+		val = val.Elem()
+	}
+	ret := TxRejectReason{Type: val.Type().Name()}
+	switch e := err.(type) {
+	case *ledger.UtxosFailure:
+		// The script failures are left as a generic value by gouroboros
+		if failures := utxosFailureReasons(e.Err.Value); failures != nil {
+			ret.Failures = failures
+			return ret
+		}
+	case *ledger.GenericError:
+		ret.Message = "unknown failure"
+		ret.Details = map[string]any{"value": rejectReasonValue(e.Value)}
+		return ret
+	}
+	if val.Kind() != reflect.Struct {
+		ret.Message = err.Error()
+		return ret
+	}
+	details := make(map[string]any)
+	for i := range val.NumField() {
+		field := val.Type().Field(i)
+		if !field.IsExported() || field.Anonymous {
+			continue
+		}
+		fieldVal := val.Field(i)
+		switch {
+		case field.Type == errorType:
+			if !fieldVal.IsNil() {
+				ret.Failures = append(
+					ret.Failures,
+					newTxRejectReason(fieldVal.Interface().(error)),
+				)
+			}
+		case field.Type == reflect.TypeFor[[]error]():
+			for _, tmpErr := range fieldVal.Interface().([]error) {
+				ret.Failures = append(ret.Failures, newTxRejectReason(tmpErr))
+			}
+		case fieldVal.CanAddr() &&
+			fieldVal.Addr().Type().Implements(errorType):
+			ret.Failures = append(
+				ret.Failures,
+				newTxRejectReason(fieldVal.Addr().Interface().(error)),
+			)
+		case field.Name == "Era" && field.Type.Kind() == reflect.Uint8:
+			details["era"] = ledger.GetEraById(uint8(fieldVal.Uint())).Name
+		default:
+			details[lowerFirst(field.Name)] = rejectReasonValue(
+				fieldVal.Interface(),
+			)
+		}
+	}
+	if len(ret.Failures) == 0 {
+		ret.Message = err.Error()
+	}
+	if len(details) > 0 {
+		ret.Details = details
+	}
+	return ret
+}
+
+// utxosFailureReasons decodes the Alonzo and later UTXOS failures which
+// report script failures. It returns nil for other failures.
+func utxosFailureReasons(value any) []TxRejectReason {
+	items, ok := value.([]any)
+	if !ok || len(items) == 0 {
+		return nil
+	}
+	switch tag, _ := items[0].(uint64); {
+	// ValidationTagMismatch IsValid TagMismatchDescription
+	case tag == 0 && len(items) == 3:
+		isValid, _ := items[1].(bool)
+		ret := TxRejectReason{
+			Type:    "ValidationTagMismatch",
+			Details: map[string]any{"isValid": isValid},
+		}
+		// FailedUnexpectedly lists the script failures
+		if desc, ok := items[2].([]any); ok && len(desc) == 2 &&
+			desc[0] == uint64(1) {
+			scriptFailures, _ := desc[1].([]any)
+			for _, scriptFailure := range scriptFailures {
+				ret.Failures = append(
+					ret.Failures,
+					scriptFailureReason(scriptFailure),
+				)
+			}
+		}
+		if len(ret.Failures) == 0 {
+			ret.Message = "the isValid flag doesn't match the result of running the scripts"
+		}
+		return []TxRejectReason{ret}
+	// CollectErrors [CollectError]
+	case tag == 1 && len(items) == 2:
+		return []TxRejectReason{
+			{
+				Type:    "CollectErrors",
+				Message: "failed to build the script contexts",
+				Details: map[string]any{
+					"errors": rejectReasonValue(items[1]),
+				},
+			},
+		}
+	}
+	return nil
+}
+
+func scriptFailureReason(value any) TxRejectReason {
+	// PlutusFailure Text ByteString
+	if items, ok := value.([]any); ok && len(items) == 3 &&
+		items[0] == uint64(1) {
+		msg, _ := items[1].(string)
+		return TxRejectReason{
+			Type:    "PlutusFailure",
+			Message: msg,
+			Details: map[string]any{
+				"reconstruction": rejectReasonValue(items[2]),
+			},
+		}
+	}
+	return TxRejectReason{
+		Type:    "ScriptFailure",
+		Details: map[string]any{"value": rejectReasonValue(value)},
+	}
+}
+
+// rejectReasonValue converts a value from a decoded rejection reason into a
+// form which can be encoded as JSON. Bytes are hex encoded and maps are keyed
+// by strings.
+func rejectReasonValue(value any) any {
+	switch v := value.(type) {
+	case nil:
+		return nil
+	case *any:
+		return rejectReasonValue(*v)
+	case cbor.Value:
+		return rejectReasonValue(v.Value())
+	case ledger.TxOut:
+		return rejectReasonValue(v.Value.Value())
+	case ledger.TxIn:
+		return fmt.Sprintf("%x#%d", v.Utxo.Bytes(), v.TxIx)
+	case cbor.ByteString:
+		return hex.EncodeToString(v.Bytes())
+	case []byte:
+		return hex.EncodeToString(v)
+	case *big.Int, string, bool:
+		return v
+	case []any:
+		ret := make([]any, 0, len(v))
+		for _, item := range v {
+			ret = append(ret, rejectReasonValue(item))
+		}
+		return ret
+	case map[any]any:
+		ret := make(map[string]any, len(v))
+		for key, item := range v {
+			ret[rejectReasonKey(key)] = rejectReasonValue(item)
+		}
+		return ret
+	}
+	val := reflect.ValueOf(value)
+	switch val.Kind() {
+	case reflect.Pointer:
+		if val.IsNil() {
+			return nil
+		}
+		return rejectReasonValue(val.Elem().Interface())
+	case reflect.Array:
+		if val.Type().Elem().Kind() == reflect.Uint8 {
+			tmpBytes := make([]byte, val.Len())
+			reflect.Copy(reflect.ValueOf(tmpBytes), val)
+			return hex.EncodeToString(tmpBytes)
+		}
+		fallthrough
+	case reflect.Slice:
+		ret := make([]any, 0, val.Len())
+		for i := range val.Len() {
+			ret = append(ret, rejectReasonValue(val.Index(i).Interface()))
+		}
+		return ret
+	case reflect.Struct:
+		ret := make(map[string]any)
+		for i := range val.NumField() {
+			field := val.Type().Field(i)
+			if !field.IsExported() || field.Anonymous {
+				continue
+			}
+			ret[lowerFirst(field.Name)] = rejectReasonValue(
+				val.Field(i).Interface(),
+			)
+		}
+		return ret
+	}
+	return value
+}
+
+func rejectReasonKey(key any) string {
+	tmpKey := rejectReasonValue(key)
+	if s, ok := tmpKey.(string); ok {
+		return s
+	}
+	if keyJson, err := json.Marshal(tmpKey); err == nil {
+		return string(keyJson)
+	}
+	return fmt.Sprint(tmpKey)
+}
+
+func lowerFirst(s string) string {
+	r, size := utf8.DecodeRuneInString(s)
+	return string(unicode.ToLower(r)) + s[size:]
+}
@@ -0,0 +1,282 @@
+// Copyright 2025 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/blinklabs-io/gouroboros/cbor"
+	"github.com/blinklabs-io/gouroboros/ledger"
+	"github.com/blinklabs-io/gouroboros/protocol/localtxsubmission"
+)
+
+func testRejectCbor(t *testing.T, utxoFailure []any) []byte {
+	t.Helper()
+	// [[era, [[UtxowFailure, [UtxoFailure, [era, failure]]]]]]
+	reason := []any{
+		[]any{
+			uint64(ledger.EraIdConway),
+			[]any{
+				[]any{
+					uint64(ledger.ApplyTxErrorUtxowFailure),
+					[]any{
+						uint64(ledger.ConwayUtxowUtxoFailure),
+						[]any{uint64(ledger.EraIdConway), utxoFailure},
+					},
+				},
+			},
+		},
+	}
+	ret, err := cbor.Encode(reason)
+	if err != nil {
+		t.Fatalf("unexpected error encoding reason: %s", err)
+	}
+	return ret
+}
+
+// testRejectLeaf returns the innermost failure of a decoded rejection
+func testRejectLeaf(t *testing.T, reason *TxRejectReason) TxRejectReason {
+	t.Helper()
+	types := []string{
+		"ShelleyTxValidationError",
+		"ApplyTxError",
+		"UtxowFailure",
+		"UtxoFailure",
+	}
+	ret := *reason
+	for _, tmpType := range types {
+		if ret.Type != tmpType {
+			t.Fatalf(
+				"did not get expected type: got %s, wanted %s",
+				ret.Type,
+				tmpType,
+			)
+		}
+		if len(ret.Failures) != 1 {
+			t.Fatalf(
+				"did not get expected failures for %s: %v",
+				ret.Type,
+				ret.Failures,
+			)
+		}
+		ret = ret.Failures[0]
+	}
+	return ret
+}
+
+func TestTxRejectReasonFromError(t *testing.T) {
+	txId := bytes.Repeat([]byte{0xab}, 32)
+	testDefs := []struct {
+		name        string
+		utxoFailure []any
+		leaf        TxRejectReason
+	}{
+		{
+			name: "BadInputsUTxO",
+			utxoFailure: []any{
+				uint64(ledger.ConwayUtxoBadInputsUTxO),
+				[]any{[]any{txId, uint64(1)}},
+			},
+			leaf: TxRejectReason{
+				Type: "BadInputsUtxo",
+				Details: map[string]any{
+					"inputs": []any{fmt.Sprintf("%x#1", txId)},
+				},
+			},
+		},
+		{
+			name: "FeeTooSmallUTxO",
+			utxoFailure: []any{
+				uint64(ledger.ConwayUtxoFeeTooSmallUTxO),
+				uint64(170000),
+				uint64(150000),
+			},
+			leaf: TxRejectReason{
+				Type: "FeeTooSmallUtxo",
+				Details: map[string]any{
+					"minimumFee":  uint64(170000),
+					"suppliedFee": uint64(150000),
+				},
+			},
+		},
+		{
+			name: "ValueNotConservedUTxO",
+			utxoFailure: []any{
+				uint64(ledger.ConwayUtxoValueNotConservedUTxO),
+				uint64(5000000),
+				uint64(4000000),
+			},
+			leaf: TxRejectReason{
+				Type: "ValueNotConservedUtxo",
+				Details: map[string]any{
+					"consumed": uint64(5000000),
+					"produced": uint64(4000000),
+				},
+			},
+		},
+		{
+			name: "ScriptFailures",
+			utxoFailure: []any{
+				uint64(ledger.ConwayUtxoUtxosFailure),
+				[]any{
+					uint64(0),
+					true,
+					[]any{
+						uint64(1),
+						[]any{
+							[]any{uint64(1), "validator crashed", []byte{0x01}},
+						},
+					},
+				},
+			},
+			leaf: TxRejectReason{
+				Type: "UtxosFailure",
+				Failures: []TxRejectReason{
+					{
+						Type:    "ValidationTagMismatch",
+						Details: map[string]any{"isValid": true},
+						Failures: []TxRejectReason{
+							{
+								Type:    "PlutusFailure",
+								Message: "validator crashed",
+								Details: map[string]any{
+									"reconstruction": "01",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, testDef := range testDefs {
+		t.Run(testDef.name, func(t *testing.T) {
+			// The client returns the rejection by value
+			err := fmt.Errorf(
+				"failed to submit: %w",
+				localtxsubmission.TransactionRejectedError{
+					ReasonCbor: testRejectCbor(t, testDef.utxoFailure),
+				},
+			)
+			reason, ok := TxRejectReasonFromError(err)
+			if !ok {
+				t.Fatalf("did not get reject reason")
+			}
+			if reason.Details["era"] != "Conway" {
+				t.Fatalf("did not get expected era: %v", reason.Details)
+			}
+			leaf := testRejectLeaf(t, reason)
+			// The messages from gouroboros aren't compared
+			if len(testDef.leaf.Failures) == 0 {
+				if leaf.Message == "" {
+					t.Fatalf("did not get failure message")
+				}
+				leaf.Message = ""
+			}
+			if !reflect.DeepEqual(leaf, testDef.leaf) {
+				t.Fatalf(
+					"did not get expected failure\n  got:    %#v\n  wanted: %#v",
+					leaf,
+					testDef.leaf,
+				)
+			}
+		})
+	}
+}
+
+func TestTxRejectReasonFromErrorPointer(t *testing.T) {
+	err := &localtxsubmission.TransactionRejectedError{
+		ReasonCbor: []byte{0xff},
+		Reason:     &ledger.GenericError{Value: "foo"},
+	}
+	reason, ok := TxRejectReasonFromError(err)
+	if !ok {
+		t.Fatalf("did not get reject reason")
+	}
+	if reason.Type != "GenericError" || reason.Details["value"] != "foo" {
+		t.Fatalf("did not get expected reject reason: %#v", reason)
+	}
+}
+
+func TestTxRejectReasonFromErrorUnknown(t *testing.T) {
+	if _, ok := TxRejectReasonFromError(errors.New("foo")); ok {
+		t.Fatalf("got reject reason for other error")
+	}
+	reason, ok := TxRejectReasonFromError(
+		localtxsubmission.TransactionRejectedError{ReasonCbor: []byte{0xff}},
+	)
+	if !ok {
+		t.Fatalf("did not get reject reason")
+	}
+	if reason.Type != "Unknown" || reason.Details["cbor"] != "ff" {
+		t.Fatalf("did not get expected reject reason: %#v", reason)
+	}
+}
+
+func TestRejectReasonValue(t *testing.T) {
+	testDefs := []struct {
+		name     string
+		value    any
+		expected any
+	}{
+		{
+			name:     "ByteString",
+			value:    cbor.NewByteString([]byte{0xde, 0xad}),
+			expected: "dead",
+		},
+		{
+			name:     "ByteArray",
+			value:    [2]byte{0xbe, 0xef},
+			expected: "beef",
+		},
+		{
+			name:     "Array",
+			value:    []any{uint64(1), []byte{0x02}},
+			expected: []any{uint64(1), "02"},
+		},
+		{
+			name: "Map",
+			value: map[any]any{
+				uint64(1):                        "foo",
+				cbor.NewByteString([]byte{0x03}): "bar",
+			},
+			expected: map[string]any{"1": "foo", "03": "bar"},
+		},
+		{
+			name: "EraInfo",
+			value: &ledger.EraInfo{
+				Index: 1,
+				Name:  "Shelley",
+			},
+			expected: map[string]any{"index": uint8(1), "name": "Shelley"},
+		},
+	}
+	for _, testDef := range testDefs {
+		t.Run(testDef.name, func(t *testing.T) {
+			value := rejectReasonValue(testDef.value)
+			if !reflect.DeepEqual(value, testDef.expected) {
+				t.Fatalf(
+					"did not get expected value\n  got:    %#v\n  wanted: %#v",
+					value,
+					testDef.expected,
+				)
+			}
+		})
+	}
+}
@@ -0,0 +1,254 @@
+// Copyright 2025 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"github.com/blinklabs-io/adder/event"
+	"github.com/blinklabs-io/cardano-node-api/internal/logging"
+)
+
+const (
+	TxStageAccepted   = "accepted"
+	TxStageMempool    = "mempool"
+	TxStageIncluded   = "included"
+	TxStageConfirmed  = "confirmed"
+	TxStageRolledBack = "rolled_back"
+	TxStageDropped    = "dropped"
+
+	txTrackerPollInterval = 1 * time.Second
+	// How long a transaction can be missing from both the mempool and the
+	// chain before it's reported as dropped. This covers the time between
+	// the node removing it from the mempool and chain-sync delivering the
+	// block which includes it.
+	txTrackerDropTimeout = 30 * time.Second
+)
+
+// TxStatus is a stage reached by a tracked transaction. The block fields are
+// set for the included, confirmed and rolled back stages.
+type TxStatus struct {
+	TxHash        string `json:"tx_hash"`
+	Stage         string `json:"stage"`
+	BlockHash     string `json:"block_hash,omitempty"`
+	BlockNumber   uint64 `json:"block_number,omitempty"`
+	Slot          uint64 `json:"slot,omitempty"`
+	Confirmations uint64 `json:"confirmations,omitempty"`
+}
+
+// TxTracker follows a submitted transaction through the mempool and onto
+// the chain until it has the requested number of confirmations or is
+// dropped. Confirmations are the blocks added on top of the block which
+// includes the transaction.
+type TxTracker struct {
+	state      *txTrackerState
+	follower   *ChainFollower
+	statusChan chan TxStatus
+	doneChan   chan struct{}
+	cancel     context.CancelFunc
+	err        error
+}
+
+// NewTxTracker creates a tracker for the transaction with the given hash
+func NewTxTracker(txHash []byte, confirmations uint64) *TxTracker {
+	return &TxTracker{
+		state:      newTxTrackerState(hex.EncodeToString(txHash), confirmations),
+		follower:   NewChainFollower(nil),
+		statusChan: make(chan TxStatus, 10),
+		doneChan:   make(chan struct{}),
+	}
+}
+
+// Start begins following the chain from the current tip. It should be
+// called before the transaction is submitted, so that a block which
+// includes it can't be missed.
+func (t *TxTracker) Start(ctx context.Context) error {
+	if err := t.follower.Start(ctx); err != nil {
+		return err
+	}
+	ctx, t.cancel = context.WithCancel(ctx)
+	go t.run(ctx)
+	return nil
+}
+
+// Stop stops tracking and waits for the status channel to be closed
+func (t *TxTracker) Stop() {
+	if t.cancel == nil {
+		// Not started
+		return
+	}
+	t.cancel()
+	<-t.doneChan
+}
+
+// StatusChan returns the channel on which stages are delivered. It is closed
+// after the final stage, or once the tracker stops.
+func (t *TxTracker) StatusChan() <-chan TxStatus {
+	return t.statusChan
+}
+
+// Err returns the error which stopped the tracker before the final stage. It
+// must only be called once the status channel is closed.
+func (t *TxTracker) Err() error {
+	return t.err
+}
+
+func (t *TxTracker) run(ctx context.Context) {
+	defer func() {
+		t.follower.Stop()
+		close(t.statusChan)
+		close(t.doneChan)
+	}()
+	logger := logging.GetLogger().With(
+		"component", "txtracker",
+		"tx_hash", t.state.txHash,
+	)
+	txHash, _ := hex.DecodeString(t.state.txHash)
+	ticker := time.NewTicker(txTrackerPollInterval)
+	defer ticker.Stop()
+	for !t.state.done {
+		var statuses []TxStatus
+		select {
+		case <-ctx.Done():
+			return
+		case evt, ok := <-t.follower.EventChan():
+			if !ok {
+				t.err = errors.New(
+					"chain-sync stopped while tracking transaction",
+				)
+				logger.Error("failed to track transaction:", "error", t.err)
+				return
+			}
+			statuses = t.state.handleEvent(evt)
+		case <-ticker.C:
+			inMempool, err := mempoolHasTx(ctx, txHash)
+			if err != nil {
+				logger.Debug("failed to check mempool", "error", err)
+				continue
+			}
+			statuses = t.state.handleMempool(inMempool, time.Now())
+		}
+		for _, status := range statuses {
+			select {
+			case <-ctx.Done():
+				return
+			case t.statusChan <- status:
+			}
+		}
+	}
+}
+
+func mempoolHasTx(ctx context.Context, txHash []byte) (bool, error) {
+	lease, err := AcquireConnection(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer lease.Release()
+	return lease.LocalTxMonitor().HasTx(txHash)
+}
+
+// txTrackerState turns chain-sync events and mempool checks into the stages
+// of a tracked transaction
+type txTrackerState struct {
+	txHash        string
+	confirmations uint64
+	inMempool     bool
+	missingSince  time.Time
+	included      *TxStatus
+	done          bool
+}
+
+func newTxTrackerState(txHash string, confirmations uint64) *txTrackerState {
+	return &txTrackerState{
+		txHash:        txHash,
+		confirmations: confirmations,
+	}
+}
+
+func (s *txTrackerState) handleEvent(evt event.Event) []TxStatus {
+	if s.done {
+		return nil
+	}
+	switch payload := evt.Payload.(type) {
+	case event.TransactionEvent:
+		if s.included != nil || payload.Transaction == nil ||
+			payload.Transaction.Hash().String() != s.txHash {
+			return nil
+		}
+		txCtx, _ := evt.Context.(event.TransactionContext)
+		s.included = &TxStatus{
+			TxHash:      s.txHash,
+			Stage:       TxStageIncluded,
+			BlockHash:   payload.BlockHash,
+			BlockNumber: txCtx.BlockNumber,
+			Slot:        txCtx.SlotNumber,
+		}
+		s.inMempool = false
+		s.done = s.confirmations == 0
+		return []TxStatus{*s.included}
+	case event.BlockEvent:
+		blockCtx, _ := evt.Context.(event.BlockContext)
+		if s.included == nil ||
+			blockCtx.BlockNumber <= s.included.BlockNumber {
+			return nil
+		}
+		status := *s.included
+		status.Stage = TxStageConfirmed
+		status.Confirmations = blockCtx.BlockNumber - s.included.BlockNumber
+		s.done = status.Confirmations >= s.confirmations
+		return []TxStatus{status}
+	case event.RollbackEvent:
+		if s.included == nil || payload.SlotNumber >= s.included.Slot {
+			return nil
+		}
+		// The block which included the transaction is no longer on the
+		// chain. Keep watching in case it's included again.
+		status := *s.included
+		status.Stage = TxStageRolledBack
+		s.included = nil
+		s.missingSince = time.Time{}
+		return []TxStatus{status}
+	}
+	return nil
+}
+
+func (s *txTrackerState) handleMempool(
+	inMempool bool,
+	now time.Time,
+) []TxStatus {
+	if s.done || s.included != nil {
+		return nil
+	}
+	if inMempool {
+		s.missingSince = time.Time{}
+		if s.inMempool {
+			return nil
+		}
+		s.inMempool = true
+		return []TxStatus{{TxHash: s.txHash, Stage: TxStageMempool}}
+	}
+	if s.missingSince.IsZero() {
+		s.missingSince = now
+		return nil
+	}
+	if now.Sub(s.missingSince) < txTrackerDropTimeout {
+		return nil
+	}
+	s.done = true
+	return []TxStatus{{TxHash: s.txHash, Stage: TxStageDropped}}
+}
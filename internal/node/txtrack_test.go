@@ -0,0 +1,217 @@
+// Copyright 2025 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/blinklabs-io/adder/event"
+	ocommon "github.com/blinklabs-io/gouroboros/protocol/common"
+)
+
+func testTrackerBlockEvent(blockNumber uint64) event.Event {
+	return event.New(
+		"chainsync.block",
+		time.Now(),
+		event.BlockContext{BlockNumber: blockNumber},
+		event.BlockEvent{},
+	)
+}
+
+func testTrackerTxEvent(
+	t *testing.T,
+	inputIndex uint32,
+	blockNumber uint64,
+	slot uint64,
+) event.Event {
+	t.Helper()
+	return event.New(
+		"chainsync.transaction",
+		time.Now(),
+		event.TransactionContext{BlockNumber: blockNumber, SlotNumber: slot},
+		event.TransactionEvent{
+			Transaction: testIndexTx(t, inputIndex, 1),
+			BlockHash:   "abcd",
+		},
+	)
+}
+
+func testTrackerRollbackEvent(slot uint64) event.Event {
+	return event.New(
+		"chainsync.rollback",
+		time.Now(),
+		nil,
+		event.NewRollbackEvent(ocommon.NewPoint(slot, []byte{0x01})),
+	)
+}
+
+func TestTxTrackerStateChain(t *testing.T) {
+	txHash := testIndexTx(t, 0, 1).Hash().String()
+	included := TxStatus{
+		TxHash:      txHash,
+		Stage:       TxStageIncluded,
+		BlockHash:   "abcd",
+		BlockNumber: 100,
+		Slot:        1000,
+	}
+	confirmed := func(confirmations uint64) TxStatus {
+		ret := included
+		ret.Stage = TxStageConfirmed
+		ret.Confirmations = confirmations
+		return ret
+	}
+	rolledBack := included
+	rolledBack.Stage = TxStageRolledBack
+	testDefs := []struct {
+		event    event.Event
+		expected []TxStatus
+		done     bool
+	}{
+		// Other transactions and blocks before inclusion are ignored
+		{event: testTrackerTxEvent(t, 1, 99, 990)},
+		{event: testTrackerBlockEvent(100)},
+		{
+			event:    testTrackerTxEvent(t, 0, 100, 1000),
+			expected: []TxStatus{included},
+		},
+		{
+			event:    testTrackerBlockEvent(101),
+			expected: []TxStatus{confirmed(1)},
+		},
+		// Rollbacks after the including block don't affect it
+		{event: testTrackerRollbackEvent(1000)},
+		{
+			event:    testTrackerRollbackEvent(999),
+			expected: []TxStatus{rolledBack},
+		},
+		{
+			event:    testTrackerTxEvent(t, 0, 100, 1000),
+			expected: []TxStatus{included},
+		},
+		{
+			event:    testTrackerBlockEvent(101),
+			expected: []TxStatus{confirmed(1)},
+		},
+		{
+			event:    testTrackerBlockEvent(102),
+			expected: []TxStatus{confirmed(2)},
+			done:     true,
+		},
+		// Nothing is reported once done
+		{event: testTrackerBlockEvent(103), done: true},
+	}
+	state := newTxTrackerState(txHash, 2)
+	for idx, testDef := range testDefs {
+		statuses := state.handleEvent(testDef.event)
+		if !reflect.DeepEqual(statuses, testDef.expected) {
+			t.Fatalf(
+				"event %d: did not get expected statuses\n  got:    %v\n  wanted: %v",
+				idx,
+				statuses,
+				testDef.expected,
+			)
+		}
+		if state.done != testDef.done {
+			t.Fatalf("event %d: did not get expected done: %v", idx, state.done)
+		}
+	}
+}
+
+func TestTxTrackerStateNoConfirmations(t *testing.T) {
+	tx := testIndexTx(t, 0, 1)
+	state := newTxTrackerState(tx.Hash().String(), 0)
+	statuses := state.handleEvent(testTrackerTxEvent(t, 0, 100, 1000))
+	if len(statuses) != 1 || statuses[0].Stage != TxStageIncluded {
+		t.Fatalf("did not get expected statuses: %v", statuses)
+	}
+	if !state.done {
+		t.Fatalf("tracker is not done after inclusion")
+	}
+}
+
+func TestTxTrackerStateMempool(t *testing.T) {
+	now := time.Now()
+	testDefs := []struct {
+		name      string
+		inMempool []bool
+		expected  []string
+		done      bool
+	}{
+		{
+			name:      "Mempool",
+			inMempool: []bool{false, true, true},
+			expected:  []string{TxStageMempool},
+		},
+		{
+			// Leaving the mempool briefly isn't reported
+			name:      "MissingBriefly",
+			inMempool: []bool{true, false, true},
+			expected:  []string{TxStageMempool},
+		},
+		{
+			name:      "Dropped",
+			inMempool: []bool{true, false, false},
+			expected:  []string{TxStageMempool, TxStageDropped},
+			done:      true,
+		},
+	}
+	for _, testDef := range testDefs {
+		t.Run(testDef.name, func(t *testing.T) {
+			state := newTxTrackerState("abcd", 1)
+			var stages []string
+			for idx, inMempool := range testDef.inMempool {
+				// Space the checks out past the drop timeout
+				checkTime := now.Add(
+					time.Duration(idx) * txTrackerDropTimeout,
+				)
+				for _, status := range state.handleMempool(
+					inMempool,
+					checkTime,
+				) {
+					stages = append(stages, status.Stage)
+				}
+			}
+			if !reflect.DeepEqual(stages, testDef.expected) {
+				t.Fatalf(
+					"did not get expected stages: got %v, wanted %v",
+					stages,
+					testDef.expected,
+				)
+			}
+			if state.done != testDef.done {
+				t.Fatalf("did not get expected done: %v", state.done)
+			}
+		})
+	}
+}
+
+func TestTxTrackerStateMempoolAfterInclusion(t *testing.T) {
+	tx := testIndexTx(t, 0, 1)
+	state := newTxTrackerState(tx.Hash().String(), 1)
+	state.handleEvent(testTrackerTxEvent(t, 0, 100, 1000))
+	// The transaction leaves the mempool once it's in a block
+	now := time.Now()
+	for idx := range 3 {
+		statuses := state.handleMempool(
+			false,
+			now.Add(time.Duration(idx)*txTrackerDropTimeout),
+		)
+		if len(statuses) != 0 {
+			t.Fatalf("did not expect statuses: %v", statuses)
+		}
+	}
+}
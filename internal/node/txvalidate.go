@@ -0,0 +1,558 @@
+// Copyright 2025 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"fmt"
+	"math/big"
+	"slices"
+	"strings"
+
+	"github.com/blinklabs-io/gouroboros/ledger"
+	lcommon "github.com/blinklabs-io/gouroboros/ledger/common"
+	"github.com/blinklabs-io/gouroboros/protocol/localstatequery"
+)
+
+// TxValidationFailure describes a ledger rule which a transaction breaks. The
+// rule is named after the matching ledger predicate failure.
+type TxValidationFailure struct {
+	Rule    string `json:"rule"`
+	Message string `json:"message"`
+}
+
+// TxValidationError is returned for a transaction which fails validation. It
+// lists every rule which the transaction breaks rather than only the first.
+type TxValidationError struct {
+	Failures []TxValidationFailure `json:"failures"`
+}
+
+func (e *TxValidationError) Error() string {
+	msgs := make([]string, 0, len(e.Failures))
+	for _, failure := range e.Failures {
+		msgs = append(msgs, failure.Message)
+	}
+	return "transaction failed validation: " + strings.Join(msgs, "; ")
+}
+
+// txValidationParams are the protocol parameters used by the validation rules
+type txValidationParams struct {
+	fees                 FeeParams
+	maxTxSize            uint
+	keyDeposit           uint
+	poolDeposit          uint
+	collateralPercentage uint
+	maxCollateralInputs  uint
+}
+
+func txValidationParamsFromProtocolParams(
+	protoParams lcommon.ProtocolParameters,
+) (txValidationParams, error) {
+	fees, err := FeeParamsFromProtocolParams(protoParams)
+	if err != nil {
+		return txValidationParams{}, err
+	}
+	ret := txValidationParams{fees: fees}
+	switch params := protoParams.(type) {
+	case *ledger.DijkstraProtocolParameters:
+		ret.maxTxSize = params.MaxTxSize
+		ret.keyDeposit = params.KeyDeposit
+		ret.poolDeposit = params.PoolDeposit
+		ret.collateralPercentage = params.CollateralPercentage
+		ret.maxCollateralInputs = params.MaxCollateralInputs
+	case *ledger.ConwayProtocolParameters:
+		ret.maxTxSize = params.MaxTxSize
+		ret.keyDeposit = params.KeyDeposit
+		ret.poolDeposit = params.PoolDeposit
+		ret.collateralPercentage = params.CollateralPercentage
+		ret.maxCollateralInputs = params.MaxCollateralInputs
+	case *ledger.BabbageProtocolParameters:
+		ret.maxTxSize = params.MaxTxSize
+		ret.keyDeposit = params.KeyDeposit
+		ret.poolDeposit = params.PoolDeposit
+		ret.collateralPercentage = params.CollateralPercentage
+		ret.maxCollateralInputs = params.MaxCollateralInputs
+	case *ledger.AlonzoProtocolParameters:
+		ret.maxTxSize = params.MaxTxSize
+		ret.keyDeposit = params.KeyDeposit
+		ret.poolDeposit = params.PoolDeposit
+		ret.collateralPercentage = params.CollateralPercentage
+		ret.maxCollateralInputs = params.MaxCollateralInputs
+	}
+	return ret, nil
+}
+
+// txValidationState is the ledger state which a transaction is validated
+// against
+type txValidationState struct {
+	slot   uint64
+	params txValidationParams
+	// Resolved inputs, collateral inputs and reference inputs, keyed by input
+	utxos map[string]ledger.Utxo
+	// Pools registered by the transaction which are already registered, and
+	// so don't take a deposit
+	registeredPools map[lcommon.PoolKeyHash]bool
+}
+
+// ValidateTx checks a transaction against the phase-1 ledger rules which can
+// be checked with the node's current ledger state: value preservation, the
+// minimum fee, the validity interval, collateral, the maximum transaction
+// size and required signers. Scripts aren't run and signatures aren't
+// verified. A *TxValidationError is returned when the transaction breaks any
+// of the rules.
+func ValidateTx(
+	client *localstatequery.Client,
+	tx ledger.Transaction,
+) error {
+	protoParams, err := client.GetCurrentProtocolParams()
+	if err != nil {
+		return err
+	}
+	params, err := txValidationParamsFromProtocolParams(protoParams)
+	if err != nil {
+		return err
+	}
+	point, err := client.GetChainPoint()
+	if err != nil {
+		return err
+	}
+	state := &txValidationState{
+		// The node validates transactions for its mempool against the slot
+		// after its tip
+		slot:            point.Slot + 1,
+		params:          params,
+		utxos:           make(map[string]ledger.Utxo),
+		registeredPools: make(map[lcommon.PoolKeyHash]bool),
+	}
+	// Resolve all inputs in a single query
+	allInputs := make(
+		[]ledger.TransactionInput,
+		0,
+		len(tx.Inputs())+len(tx.Collateral())+len(tx.ReferenceInputs()),
+	)
+	allInputs = append(allInputs, tx.Inputs()...)
+	allInputs = append(allInputs, tx.Collateral()...)
+	allInputs = append(allInputs, tx.ReferenceInputs()...)
+	utxos, err := UtxosByTxIn(client, allInputs)
+	if err != nil {
+		return fmt.Errorf("query UTxOs: %w", err)
+	}
+	for _, utxo := range utxos {
+		state.utxos[utxo.Id.String()] = utxo
+	}
+	// Look up the pools registered by the transaction
+	var poolIds []ledger.PoolId
+	for _, cert := range tx.Certificates() {
+		if poolCert, ok := cert.(*lcommon.PoolRegistrationCertificate); ok {
+			poolIds = append(poolIds, ledger.PoolId(poolCert.Operator))
+		}
+	}
+	if len(poolIds) > 0 {
+		pools, err := client.GetStakePoolParams(poolIds)
+		if err != nil {
+			return fmt.Errorf("query stake pool params: %w", err)
+		}
+		for poolId := range pools.Results {
+			state.registeredPools[lcommon.PoolKeyHash(poolId)] = true
+		}
+	}
+	failures, err := validateTx(tx, state)
+	if err != nil {
+		return err
+	}
+	if len(failures) > 0 {
+		return &TxValidationError{Failures: failures}
+	}
+	return nil
+}
+
+// txValidator collects the rules broken by a transaction
+type txValidator struct {
+	tx            ledger.Transaction
+	state         *txValidationState
+	exUnits       lcommon.ExUnits
+	hasRedeemers  bool
+	missingInputs bool
+	failures      []TxValidationFailure
+}
+
+func validateTx(
+	tx ledger.Transaction,
+	state *txValidationState,
+) ([]TxValidationFailure, error) {
+	v := &txValidator{
+		tx:    tx,
+		state: state,
+	}
+	if witnesses := tx.Witnesses(); witnesses != nil &&
+		witnesses.Redeemers() != nil {
+		for _, redeemer := range witnesses.Redeemers().Iter() {
+			v.hasRedeemers = true
+			v.exUnits.Memory += redeemer.ExUnits.Memory
+			v.exUnits.Steps += redeemer.ExUnits.Steps
+		}
+	}
+	v.checkInputs()
+	v.checkValidityInterval()
+	v.checkTxSize()
+	if err := v.checkFee(); err != nil {
+		return nil, err
+	}
+	v.checkValue()
+	v.checkCollateral()
+	v.checkRequiredSigners()
+	return v.failures, nil
+}
+
+func (v *txValidator) fail(rule string, format string, args ...any) {
+	v.failures = append(
+		v.failures,
+		TxValidationFailure{
+			Rule:    rule,
+			Message: fmt.Sprintf(format, args...),
+		},
+	)
+}
+
+// resolve returns the resolved outputs for the provided inputs. Inputs which
+// aren't in the UTxO set are skipped.
+func (v *txValidator) resolve(
+	inputs ...[]ledger.TransactionInput,
+) map[string]ledger.Utxo {
+	ret := make(map[string]ledger.Utxo)
+	for _, tmpInputs := range inputs {
+		for _, input := range tmpInputs {
+			if utxo, ok := v.state.utxos[input.String()]; ok {
+				ret[input.String()] = utxo
+			}
+		}
+	}
+	return ret
+}
+
+func (v *txValidator) checkInputs() {
+	if len(v.tx.Inputs()) == 0 {
+		v.fail("InputSetEmptyUTxO", "transaction has no inputs")
+	}
+	for _, tmpInputs := range []struct {
+		name   string
+		inputs []ledger.TransactionInput
+	}{
+		{name: "input", inputs: v.tx.Inputs()},
+		{name: "collateral input", inputs: v.tx.Collateral()},
+		{name: "reference input", inputs: v.tx.ReferenceInputs()},
+	} {
+		for _, input := range tmpInputs.inputs {
+			if _, ok := v.state.utxos[input.String()]; ok {
+				continue
+			}
+			v.missingInputs = true
+			v.fail(
+				"BadInputsUTxO",
+				"%s %s is not in the UTxO set",
+				tmpInputs.name,
+				input.String(),
+			)
+		}
+	}
+}
+
+func (v *txValidator) checkValidityInterval() {
+	slot := v.state.slot
+	if start := v.tx.ValidityIntervalStart(); start > 0 && slot < start {
+		v.fail(
+			"OutsideValidityIntervalUTxO",
+			"transaction isn't valid until slot %d, current slot is %d",
+			start,
+			slot,
+		)
+	}
+	if ttl := v.tx.TTL(); ttl > 0 && slot >= ttl {
+		v.fail(
+			"OutsideValidityIntervalUTxO",
+			"transaction expired at slot %d, current slot is %d",
+			ttl,
+			slot,
+		)
+	}
+}
+
+func (v *txValidator) checkTxSize() {
+	txSize := uint(len(v.tx.Cbor()))
+	if txSize > v.state.params.maxTxSize {
+		v.fail(
+			"MaxTxSizeUTxO",
+			"transaction size %d bytes exceeds the maximum of %d bytes",
+			txSize,
+			v.state.params.maxTxSize,
+		)
+	}
+}
+
+func (v *txValidator) checkFee() error {
+	minFee, err := v.state.params.fees.MinFee(
+		v.tx,
+		v.exUnits,
+		RefScriptSize(v.resolve(v.tx.Inputs(), v.tx.ReferenceInputs())),
+	)
+	if err != nil {
+		return fmt.Errorf("compute minimum fee: %w", err)
+	}
+	fee := v.tx.Fee()
+	if fee == nil {
+		fee = new(big.Int)
+	}
+	if fee.Cmp(minFee) < 0 {
+		v.fail(
+			"FeeTooSmallUTxO",
+			"fee of %s lovelace is less than the minimum fee of %s lovelace",
+			fee,
+			minFee,
+		)
+	}
+	return nil
+}
+
+func (v *txValidator) checkValue() {
+	// The consumed value can't be known without all inputs, which are
+	// already reported
+	if v.missingInputs {
+		return
+	}
+	params := v.state.params
+	consumed := new(big.Int)
+	produced := new(big.Int)
+	consumedAssets := make(map[string]*big.Int)
+	producedAssets := make(map[string]*big.Int)
+	for _, utxo := range v.resolve(v.tx.Inputs()) {
+		addAmount(consumed, utxo.Output.Amount())
+		addAssets(consumedAssets, utxo.Output.Assets())
+	}
+	for _, amount := range v.tx.Withdrawals() {
+		addAmount(consumed, amount)
+	}
+	addAssets(consumedAssets, v.tx.AssetMint())
+	for _, output := range v.tx.Outputs() {
+		addAmount(produced, output.Amount())
+		addAssets(producedAssets, output.Assets())
+	}
+	addAmount(produced, v.tx.Fee())
+	addAmount(produced, v.tx.Donation())
+	for _, proposal := range v.tx.ProposalProcedures() {
+		produced.Add(produced, new(big.Int).SetUint64(proposal.Deposit()))
+	}
+	// Certificates take deposits or return them
+	keyDeposit := new(big.Int).SetUint64(uint64(params.keyDeposit))
+	poolDeposit := new(big.Int).SetUint64(uint64(params.poolDeposit))
+	for _, cert := range v.tx.Certificates() {
+		switch c := cert.(type) {
+		case *lcommon.StakeRegistrationCertificate:
+			produced.Add(produced, keyDeposit)
+		case *lcommon.StakeDeregistrationCertificate:
+			consumed.Add(consumed, keyDeposit)
+		case *lcommon.PoolRegistrationCertificate:
+			if !v.state.registeredPools[c.Operator] {
+				produced.Add(produced, poolDeposit)
+			}
+		case *lcommon.RegistrationCertificate:
+			produced.Add(produced, big.NewInt(c.Amount))
+		case *lcommon.DeregistrationCertificate:
+			consumed.Add(consumed, big.NewInt(c.Amount))
+		case *lcommon.RegistrationDrepCertificate:
+			produced.Add(produced, big.NewInt(c.Amount))
+		case *lcommon.DeregistrationDrepCertificate:
+			consumed.Add(consumed, big.NewInt(c.Amount))
+		case *lcommon.StakeRegistrationDelegationCertificate:
+			produced.Add(produced, big.NewInt(c.Amount))
+		case *lcommon.StakeVoteRegistrationDelegationCertificate:
+			produced.Add(produced, big.NewInt(c.Amount))
+		case *lcommon.VoteRegistrationDelegationCertificate:
+			produced.Add(produced, big.NewInt(c.Amount))
+		}
+	}
+	if consumed.Cmp(produced) != 0 {
+		v.fail(
+			"ValueNotConservedUTxO",
+			"value not conserved: consumed %s lovelace, produced %s lovelace",
+			consumed,
+			produced,
+		)
+	}
+	for _, asset := range assetDifferences(consumedAssets, producedAssets) {
+		v.fail(
+			"ValueNotConservedUTxO",
+			"value not conserved for asset %s: consumed %s, produced %s",
+			asset,
+			amountOrZero(consumedAssets[asset]),
+			amountOrZero(producedAssets[asset]),
+		)
+	}
+}
+
+func (v *txValidator) checkCollateral() {
+	// Collateral is only needed to run scripts
+	if !v.hasRedeemers {
+		return
+	}
+	params := v.state.params
+	collateral := v.tx.Collateral()
+	if len(collateral) == 0 {
+		v.fail(
+			"NoCollateralInputs",
+			"transaction has redeemers but no collateral inputs",
+		)
+		return
+	}
+	if uint(len(collateral)) > params.maxCollateralInputs {
+		v.fail(
+			"TooManyCollateralInputs",
+			"transaction has %d collateral inputs, the maximum is %d",
+			len(collateral),
+			params.maxCollateralInputs,
+		)
+	}
+	resolved := v.resolve(collateral)
+	for _, input := range collateral {
+		utxo, ok := resolved[input.String()]
+		if !ok {
+			continue
+		}
+		addr := utxo.Output.Address()
+		if addr.Type()&lcommon.AddressTypeScriptBit != 0 {
+			v.fail(
+				"ScriptsNotPaidUTxO",
+				"collateral input %s is locked by a script",
+				input.String(),
+			)
+		}
+	}
+	// The collateral balance can't be known without all collateral inputs,
+	// which are already reported
+	if len(resolved) < len(collateral) {
+		return
+	}
+	balance := new(big.Int)
+	assets := make(map[string]*big.Int)
+	for _, utxo := range resolved {
+		addAmount(balance, utxo.Output.Amount())
+		addAssets(assets, utxo.Output.Assets())
+	}
+	returnedAssets := make(map[string]*big.Int)
+	if collReturn := v.tx.CollateralReturn(); collReturn != nil {
+		addAmount(balance, new(big.Int).Neg(amountOrZero(collReturn.Amount())))
+		addAssets(returnedAssets, collReturn.Assets())
+	}
+	if len(assetDifferences(assets, returnedAssets)) > 0 {
+		v.fail(
+			"CollateralContainsNonADA",
+			"collateral contains assets which aren't returned by the collateral return output",
+		)
+	}
+	// The collateral must cover the configured percentage of the fee
+	required := new(big.Int).Mul(
+		amountOrZero(v.tx.Fee()),
+		new(big.Int).SetUint64(uint64(params.collateralPercentage)),
+	)
+	if new(big.Int).Mul(balance, big.NewInt(100)).Cmp(required) < 0 {
+		v.fail(
+			"InsufficientCollateral",
+			"collateral of %s lovelace is less than the required %s lovelace",
+			balance,
+			ratCeil(new(big.Rat).SetFrac(required, big.NewInt(100))),
+		)
+	}
+	// A zero total collateral means that the field isn't set
+	total := v.tx.TotalCollateral()
+	if total != nil && total.Sign() != 0 && total.Cmp(balance) != 0 {
+		v.fail(
+			"IncorrectTotalCollateralField",
+			"total collateral field of %s lovelace doesn't match the collateral of %s lovelace",
+			total,
+			balance,
+		)
+	}
+}
+
+func (v *txValidator) checkRequiredSigners() {
+	witnessed := make(map[lcommon.Blake2b224]bool)
+	if witnesses := v.tx.Witnesses(); witnesses != nil {
+		for _, vkey := range witnesses.Vkey() {
+			witnessed[lcommon.Blake2b224Hash(vkey.Vkey)] = true
+		}
+	}
+	for _, signer := range v.tx.RequiredSigners() {
+		if !witnessed[signer] {
+			v.fail(
+				"MissingVKeyWitnessesUTXOW",
+				"required signer %s has no vkey witness",
+				signer.String(),
+			)
+		}
+	}
+}
+
+func addAmount(total *big.Int, amount *big.Int) {
+	if amount != nil {
+		total.Add(total, amount)
+	}
+}
+
+// addAssets adds the provided assets to a map of totals keyed by policy ID
+// and hex-encoded asset name
+func addAssets(
+	totals map[string]*big.Int,
+	assets *lcommon.MultiAsset[*big.Int],
+) {
+	if assets == nil {
+		return
+	}
+	for _, policy := range assets.Policies() {
+		for _, assetName := range assets.Assets(policy) {
+			amount := assets.Asset(policy, assetName)
+			if amount == nil {
+				continue
+			}
+			key := fmt.Sprintf("%s.%x", policy.String(), assetName)
+			if totals[key] == nil {
+				totals[key] = new(big.Int)
+			}
+			totals[key].Add(totals[key], amount)
+		}
+	}
+}
+
+// assetDifferences returns the keys of the assets whose totals differ, in
+// sorted order
+func assetDifferences(a, b map[string]*big.Int) []string {
+	var ret []string
+	for key, amount := range a {
+		if amount.Cmp(amountOrZero(b[key])) != 0 {
+			ret = append(ret, key)
+		}
+	}
+	for key, amount := range b {
+		if _, ok := a[key]; !ok && amount.Sign() != 0 {
+			ret = append(ret, key)
+		}
+	}
+	slices.Sort(ret)
+	return ret
+}
+
+func amountOrZero(amount *big.Int) *big.Int {
+	if amount == nil {
+		return new(big.Int)
+	}
+	return amount
+}
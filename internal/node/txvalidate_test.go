@@ -0,0 +1,429 @@
+// Copyright 2025 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"bytes"
+	"math/big"
+	"slices"
+	"testing"
+
+	"github.com/blinklabs-io/gouroboros/cbor"
+	"github.com/blinklabs-io/gouroboros/ledger"
+	"github.com/blinklabs-io/gouroboros/ledger/babbage"
+	lcommon "github.com/blinklabs-io/gouroboros/ledger/common"
+	"github.com/blinklabs-io/gouroboros/ledger/conway"
+	"github.com/blinklabs-io/gouroboros/ledger/mary"
+	"github.com/blinklabs-io/gouroboros/ledger/shelley"
+)
+
+var (
+	testCollateralTxId = lcommon.NewBlake2b256(bytes.Repeat([]byte{0x03}, 32))
+	testPolicyId       = lcommon.NewBlake2b224(bytes.Repeat([]byte{0x04}, 28))
+)
+
+func testValidationAddress(t *testing.T, addrType uint8) lcommon.Address {
+	t.Helper()
+	addr, err := lcommon.NewAddressFromParts(
+		addrType,
+		lcommon.AddressNetworkTestnet,
+		bytes.Repeat([]byte{0x01}, 28),
+		bytes.Repeat([]byte{0x02}, 28),
+	)
+	if err != nil {
+		t.Fatalf("unexpected error creating address: %s", err)
+	}
+	return addr
+}
+
+func testValidationOutput(
+	t *testing.T,
+	addrType uint8,
+	amount uint64,
+	withAssets bool,
+) *babbage.BabbageTransactionOutput {
+	t.Helper()
+	ret := &babbage.BabbageTransactionOutput{
+		OutputAddress: testValidationAddress(t, addrType),
+		OutputAmount:  mary.MaryTransactionOutputValue{Amount: amount},
+	}
+	if withAssets {
+		assets := lcommon.NewMultiAsset(
+			map[lcommon.Blake2b224]map[cbor.ByteString]*big.Int{
+				testPolicyId: {
+					cbor.NewByteString([]byte("test")): big.NewInt(1),
+				},
+			},
+		)
+		ret.OutputAmount.Assets = &assets
+	}
+	return ret
+}
+
+// testValidationTx returns a decoded transaction which spends the 1000200
+// lovelace in testValidationState to a 1000000 lovelace output with a 200
+// lovelace fee, after applying the provided changes
+func testValidationTx(
+	t *testing.T,
+	modify func(*conway.ConwayTransaction),
+) ledger.Transaction {
+	t.Helper()
+	tx := &conway.ConwayTransaction{
+		Body: conway.ConwayTransactionBody{
+			TxInputs: conway.NewConwayTransactionInputSet(
+				[]shelley.ShelleyTransactionInput{{OutputIndex: 0}},
+			),
+			TxOutputs: []babbage.BabbageTransactionOutput{
+				*testValidationOutput(
+					t,
+					lcommon.AddressTypeKeyKey,
+					1000000,
+					false,
+				),
+			},
+			TxFee: 200,
+		},
+		TxIsValid: true,
+	}
+	if modify != nil {
+		modify(tx)
+	}
+	txCbor, err := cbor.Encode(tx)
+	if err != nil {
+		t.Fatalf("unexpected error encoding transaction: %s", err)
+	}
+	ret, err := conway.NewConwayTransactionFromCbor(txCbor)
+	if err != nil {
+		t.Fatalf("unexpected error decoding transaction: %s", err)
+	}
+	return ret
+}
+
+func testValidationState(t *testing.T) *txValidationState {
+	t.Helper()
+	state := &txValidationState{
+		slot: 100,
+		params: txValidationParams{
+			fees:                 FeeParams{minFeeB: 200},
+			maxTxSize:            16384,
+			keyDeposit:           2000000,
+			poolDeposit:          500000000,
+			collateralPercentage: 150,
+			maxCollateralInputs:  3,
+		},
+		utxos:           make(map[string]ledger.Utxo),
+		registeredPools: make(map[lcommon.PoolKeyHash]bool),
+	}
+	for _, utxo := range []ledger.Utxo{
+		{
+			Id: shelley.ShelleyTransactionInput{OutputIndex: 0},
+			Output: testValidationOutput(
+				t,
+				lcommon.AddressTypeKeyKey,
+				1000200,
+				false,
+			),
+		},
+		{
+			Id: shelley.ShelleyTransactionInput{
+				TxId:        testCollateralTxId,
+				OutputIndex: 0,
+			},
+			Output: testValidationOutput(
+				t,
+				lcommon.AddressTypeKeyKey,
+				5000000,
+				false,
+			),
+		},
+		{
+			Id: shelley.ShelleyTransactionInput{
+				TxId:        testCollateralTxId,
+				OutputIndex: 1,
+			},
+			Output: testValidationOutput(
+				t,
+				lcommon.AddressTypeScriptKey,
+				5000000,
+				false,
+			),
+		},
+		{
+			Id: shelley.ShelleyTransactionInput{
+				TxId:        testCollateralTxId,
+				OutputIndex: 2,
+			},
+			Output: testValidationOutput(
+				t,
+				lcommon.AddressTypeKeyKey,
+				200,
+				false,
+			),
+		},
+		{
+			Id: shelley.ShelleyTransactionInput{
+				TxId:        testCollateralTxId,
+				OutputIndex: 3,
+			},
+			Output: testValidationOutput(
+				t,
+				lcommon.AddressTypeKeyKey,
+				5000000,
+				true,
+			),
+		},
+	} {
+		state.utxos[utxo.Id.String()] = utxo
+	}
+	return state
+}
+
+func failureRules(failures []TxValidationFailure) []string {
+	ret := make([]string, 0, len(failures))
+	for _, failure := range failures {
+		ret = append(ret, failure.Rule)
+	}
+	return ret
+}
+
+func TestValidateTx(t *testing.T) {
+	testDefs := []struct {
+		name        string
+		modify      func(*conway.ConwayTransaction)
+		modifyState func(*txValidationState)
+		expected    []string
+	}{
+		{
+			name:     "valid",
+			expected: []string{},
+		},
+		{
+			name: "missing input",
+			modify: func(tx *conway.ConwayTransaction) {
+				tx.Body.TxInputs = conway.NewConwayTransactionInputSet(
+					[]shelley.ShelleyTransactionInput{{OutputIndex: 5}},
+				)
+			},
+			expected: []string{"BadInputsUTxO"},
+		},
+		{
+			name: "value not conserved",
+			modify: func(tx *conway.ConwayTransaction) {
+				tx.Body.TxOutputs[0].OutputAmount.Amount = 900000
+			},
+			expected: []string{"ValueNotConservedUTxO"},
+		},
+		{
+			name: "minted assets not spent",
+			modify: func(tx *conway.ConwayTransaction) {
+				mint := lcommon.NewMultiAsset(
+					map[lcommon.Blake2b224]map[cbor.ByteString]*big.Int{
+						testPolicyId: {
+							cbor.NewByteString([]byte("test")): big.NewInt(1),
+						},
+					},
+				)
+				tx.Body.TxMint = &mint
+			},
+			expected: []string{"ValueNotConservedUTxO"},
+		},
+		{
+			name: "fee too small",
+			modify: func(tx *conway.ConwayTransaction) {
+				tx.Body.TxFee = 100
+				tx.Body.TxOutputs[0].OutputAmount.Amount = 1000100
+			},
+			expected: []string{"FeeTooSmallUTxO"},
+		},
+		{
+			name: "expired",
+			modify: func(tx *conway.ConwayTransaction) {
+				tx.Body.Ttl = 100
+			},
+			expected: []string{"OutsideValidityIntervalUTxO"},
+		},
+		{
+			name: "not yet valid",
+			modify: func(tx *conway.ConwayTransaction) {
+				tx.Body.TxValidityIntervalStart = 200
+			},
+			expected: []string{"OutsideValidityIntervalUTxO"},
+		},
+		{
+			name: "too large",
+			modifyState: func(state *txValidationState) {
+				state.params.maxTxSize = 10
+			},
+			expected: []string{"MaxTxSizeUTxO"},
+		},
+		{
+			name: "missing required signer",
+			modify: func(tx *conway.ConwayTransaction) {
+				tx.Body.TxRequiredSigners = cbor.NewSetType(
+					[]lcommon.Blake2b224{testPolicyId},
+					false,
+				)
+			},
+			expected: []string{"MissingVKeyWitnessesUTXOW"},
+		},
+		{
+			name: "multiple failures",
+			modify: func(tx *conway.ConwayTransaction) {
+				tx.Body.Ttl = 50
+				tx.Body.TxFee = 100
+				tx.Body.TxOutputs[0].OutputAmount.Amount = 1000100
+			},
+			expected: []string{
+				"OutsideValidityIntervalUTxO",
+				"FeeTooSmallUTxO",
+			},
+		},
+	}
+	for _, testDef := range testDefs {
+		t.Run(testDef.name, func(t *testing.T) {
+			state := testValidationState(t)
+			if testDef.modifyState != nil {
+				testDef.modifyState(state)
+			}
+			failures, err := validateTx(
+				testValidationTx(t, testDef.modify),
+				state,
+			)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if !slices.Equal(failureRules(failures), testDef.expected) {
+				t.Fatalf(
+					"unexpected failures: got %+v, expected %v",
+					failures,
+					testDef.expected,
+				)
+			}
+		})
+	}
+}
+
+func TestTxValidatorCollateral(t *testing.T) {
+	collateral := func(indexes ...uint32) func(*conway.ConwayTransaction) {
+		return func(tx *conway.ConwayTransaction) {
+			inputs := make([]shelley.ShelleyTransactionInput, 0, len(indexes))
+			for _, idx := range indexes {
+				inputs = append(
+					inputs,
+					shelley.ShelleyTransactionInput{
+						TxId:        testCollateralTxId,
+						OutputIndex: idx,
+					},
+				)
+			}
+			tx.Body.TxCollateral = cbor.NewSetType(inputs, false)
+		}
+	}
+	testDefs := []struct {
+		name        string
+		modify      func(*conway.ConwayTransaction)
+		modifyState func(*txValidationState)
+		expected    []string
+	}{
+		{
+			name:     "no collateral",
+			expected: []string{"NoCollateralInputs"},
+		},
+		{
+			name:     "valid",
+			modify:   collateral(0),
+			expected: []string{},
+		},
+		{
+			name:   "too many inputs",
+			modify: collateral(0),
+			modifyState: func(state *txValidationState) {
+				state.params.maxCollateralInputs = 0
+			},
+			expected: []string{"TooManyCollateralInputs"},
+		},
+		{
+			name:     "locked by script",
+			modify:   collateral(1),
+			expected: []string{"ScriptsNotPaidUTxO"},
+		},
+		{
+			name:     "insufficient",
+			modify:   collateral(2),
+			expected: []string{"InsufficientCollateral"},
+		},
+		{
+			name:     "assets not returned",
+			modify:   collateral(3),
+			expected: []string{"CollateralContainsNonADA"},
+		},
+		{
+			name: "assets returned",
+			modify: func(tx *conway.ConwayTransaction) {
+				collateral(3)(tx)
+				tx.Body.TxCollateralReturn = testValidationOutput(
+					t,
+					lcommon.AddressTypeKeyKey,
+					4000000,
+					true,
+				)
+				tx.Body.TxTotalCollateral = 1000000
+			},
+			expected: []string{},
+		},
+		{
+			name: "incorrect total collateral",
+			modify: func(tx *conway.ConwayTransaction) {
+				collateral(0)(tx)
+				tx.Body.TxTotalCollateral = 1000000
+			},
+			expected: []string{"IncorrectTotalCollateralField"},
+		},
+	}
+	for _, testDef := range testDefs {
+		t.Run(testDef.name, func(t *testing.T) {
+			state := testValidationState(t)
+			if testDef.modifyState != nil {
+				testDef.modifyState(state)
+			}
+			v := &txValidator{
+				tx:           testValidationTx(t, testDef.modify),
+				state:        state,
+				hasRedeemers: true,
+			}
+			v.checkCollateral()
+			if !slices.Equal(failureRules(v.failures), testDef.expected) {
+				t.Fatalf(
+					"unexpected failures: got %+v, expected %v",
+					v.failures,
+					testDef.expected,
+				)
+			}
+		})
+	}
+}
+
+func TestTxValidationError(t *testing.T) {
+	err := &TxValidationError{
+		Failures: []TxValidationFailure{
+			{Rule: "FeeTooSmallUTxO", Message: "fee too small"},
+			{Rule: "MaxTxSizeUTxO", Message: "too large"},
+		},
+	}
+	expected := "transaction failed validation: fee too small; too large"
+	if err.Error() != expected {
+		t.Fatalf("unexpected error message: %s", err.Error())
+	}
+}
@@ -0,0 +1,400 @@
+// Copyright 2025 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/blinklabs-io/cardano-node-api/internal/config"
+	"github.com/blinklabs-io/cardano-node-api/internal/logging"
+	ouroboros "github.com/blinklabs-io/gouroboros"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// Upstream states, in order of preference
+const (
+	UpstreamStateHealthy = "healthy"
+	UpstreamStateUnknown = "unknown"
+	UpstreamStateLagging = "lagging"
+	UpstreamStateDown    = "down"
+)
+
+var metricUpstreamHealthy = promauto.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Name: "cardano_node_api_upstream_healthy",
+		Help: "Whether the upstream cardano-node is currently healthy",
+	},
+	[]string{"upstream"},
+)
+
+var (
+	globalUpstreams     *UpstreamSet
+	globalUpstreamsOnce sync.Once
+)
+
+// GetUpstreams returns the global set of upstream nodes, creating it from the
+// config on first use
+func GetUpstreams() *UpstreamSet {
+	globalUpstreamsOnce.Do(func() {
+		cfg := config.GetConfig()
+		globalUpstreams = NewUpstreamSet(
+			cfg.Node.UpstreamList(),
+			cfg.Node.UpstreamMaxSlotLag,
+		)
+	})
+	return globalUpstreams
+}
+
+// Upstream tracks the health of a single cardano-node
+type Upstream struct {
+	mu        sync.Mutex
+	cfg       config.UpstreamConfig
+	index     int
+	state     string
+	lastErr   error
+	lastCheck time.Time
+	latency   time.Duration
+	tipSlot   uint64
+	tipBlock  uint64
+	tipHash   []byte
+	tipTime   time.Time
+}
+
+// UpstreamStatus is a point-in-time snapshot of an upstream's health
+type UpstreamStatus struct {
+	Name       string     `json:"name"`
+	Address    string     `json:"address"`
+	State      string     `json:"state"`
+	Preferred  bool       `json:"preferred"`
+	TipSlot    uint64     `json:"tip_slot"`
+	TipBlock   uint64     `json:"tip_block"`
+	TipHash    string     `json:"tip_hash"`
+	SlotLag    uint64     `json:"slot_lag"`
+	LatencyMs  int64      `json:"latency_ms"`
+	LastCheck  *time.Time `json:"last_check,omitempty"`
+	TipUpdated *time.Time `json:"tip_updated,omitempty"`
+	LastError  string     `json:"last_error,omitempty"`
+}
+
+// Name returns the configured name of the upstream
+func (u *Upstream) Name() string {
+	return u.cfg.Name
+}
+
+// Healthy returns whether the upstream is usable for new connections. An
+// upstream which has not been checked yet is assumed to be usable.
+func (u *Upstream) Healthy() bool {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	return u.state == UpstreamStateHealthy || u.state == UpstreamStateUnknown
+}
+
+func (u *Upstream) markDown(err error) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	u.state = UpstreamStateDown
+	u.lastErr = err
+	metricUpstreamHealthy.WithLabelValues(u.cfg.Name).Set(0)
+}
+
+// markReachable clears a previous connection failure until the next health
+// check determines the upstream's state
+func (u *Upstream) markReachable() {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	if u.state == UpstreamStateDown {
+		u.state = UpstreamStateUnknown
+	}
+}
+
+func (u *Upstream) recordCheck(
+	slot uint64,
+	blockNo uint64,
+	hash []byte,
+	latency time.Duration,
+) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	now := time.Now()
+	if slot != u.tipSlot || u.tipTime.IsZero() {
+		u.tipTime = now
+	}
+	u.tipSlot = slot
+	u.tipBlock = blockNo
+	u.tipHash = hash
+	u.latency = latency
+	u.lastCheck = now
+	u.lastErr = nil
+	// The state is finalized once all upstreams have been checked, since
+	// lag is relative to the best tip
+	u.state = UpstreamStateHealthy
+}
+
+// UpstreamSet routes new node connections to the healthiest upstream and
+// fails over to the next one when dialing fails
+type UpstreamSet struct {
+	upstreams   []*Upstream
+	maxSlotLag  uint64
+	dialFunc    func(config.UpstreamConfig, *ConnectionConfig) (*ouroboros.Connection, error)
+	checkFunc   func(*Upstream) error
+	startOnce   sync.Once
+	checkMutex  sync.Mutex
+	lastBestTip atomic.Uint64
+}
+
+// NewUpstreamSet creates an upstream set for the provided upstream configs.
+// Upstreams whose tip is more than maxSlotLag slots behind the best upstream
+// are considered to be lagging.
+func NewUpstreamSet(
+	upstreams []config.UpstreamConfig,
+	maxSlotLag uint64,
+) *UpstreamSet {
+	s := &UpstreamSet{
+		maxSlotLag: maxSlotLag,
+		dialFunc:   dialUpstream,
+	}
+	s.checkFunc = s.checkUpstream
+	for idx, cfg := range upstreams {
+		s.upstreams = append(
+			s.upstreams,
+			&Upstream{
+				cfg:   cfg,
+				index: idx,
+				state: UpstreamStateUnknown,
+			},
+		)
+	}
+	return s
+}
+
+// Ranked returns the upstreams in order of preference: healthy upstreams
+// first, then by most recent tip, then in config order
+func (s *UpstreamSet) Ranked() []*Upstream {
+	type rankedUpstream struct {
+		upstream *Upstream
+		rank     int
+		tipSlot  uint64
+	}
+	tmp := make([]rankedUpstream, 0, len(s.upstreams))
+	for _, u := range s.upstreams {
+		u.mu.Lock()
+		tmp = append(
+			tmp,
+			rankedUpstream{
+				upstream: u,
+				rank:     upstreamStateRank(u.state),
+				tipSlot:  u.tipSlot,
+			},
+		)
+		u.mu.Unlock()
+	}
+	slices.SortStableFunc(tmp, func(a, b rankedUpstream) int {
+		if a.rank != b.rank {
+			return a.rank - b.rank
+		}
+		if a.tipSlot != b.tipSlot {
+			if a.tipSlot > b.tipSlot {
+				return -1
+			}
+			return 1
+		}
+		return a.upstream.index - b.upstream.index
+	})
+	ret := make([]*Upstream, 0, len(tmp))
+	for _, r := range tmp {
+		ret = append(ret, r.upstream)
+	}
+	return ret
+}
+
+func upstreamStateRank(state string) int {
+	switch state {
+	case UpstreamStateHealthy:
+		return 0
+	case UpstreamStateUnknown:
+		return 1
+	case UpstreamStateLagging:
+		return 2
+	default:
+		return 3
+	}
+}
+
+// Dial connects to the best available upstream, failing over to the next
+// upstream in order of preference when a connection attempt fails
+func (s *UpstreamSet) Dial(
+	connCfg *ConnectionConfig,
+) (*ouroboros.Connection, *Upstream, error) {
+	if len(s.upstreams) == 0 {
+		return nil, nil, errors.New(
+			"you must specify either the UNIX socket path or the address/port for your cardano-node",
+		)
+	}
+	var errs []error
+	for _, u := range s.Ranked() {
+		oConn, err := s.dialFunc(u.cfg, connCfg)
+		if err == nil {
+			u.markReachable()
+			return oConn, u, nil
+		}
+		u.markDown(err)
+		if len(s.upstreams) > 1 {
+			logging.GetLogger().Warn(
+				"failed to connect to upstream node, trying next",
+				"upstream", u.Name(),
+				"error", err,
+			)
+		}
+		errs = append(errs, err)
+	}
+	return nil, nil, errors.Join(errs...)
+}
+
+// Status returns a snapshot of the health of all upstreams, in config order
+func (s *UpstreamSet) Status() []UpstreamStatus {
+	var preferred *Upstream
+	if ranked := s.Ranked(); len(ranked) > 0 {
+		preferred = ranked[0]
+	}
+	bestTip := s.lastBestTip.Load()
+	ret := make([]UpstreamStatus, 0, len(s.upstreams))
+	for _, u := range s.upstreams {
+		u.mu.Lock()
+		status := UpstreamStatus{
+			Name:      u.cfg.Name,
+			Address:   u.cfg.String(),
+			State:     u.state,
+			Preferred: u == preferred,
+			TipSlot:   u.tipSlot,
+			TipBlock:  u.tipBlock,
+			TipHash:   hex.EncodeToString(u.tipHash),
+			LatencyMs: u.latency.Milliseconds(),
+		}
+		if bestTip > u.tipSlot && !u.lastCheck.IsZero() {
+			status.SlotLag = bestTip - u.tipSlot
+		}
+		if !u.lastCheck.IsZero() {
+			lastCheck := u.lastCheck
+			status.LastCheck = &lastCheck
+		}
+		if !u.tipTime.IsZero() {
+			tipTime := u.tipTime
+			status.TipUpdated = &tipTime
+		}
+		if u.lastErr != nil {
+			status.LastError = u.lastErr.Error()
+		}
+		u.mu.Unlock()
+		ret = append(ret, status)
+	}
+	return ret
+}
+
+// Start runs health checks against all upstreams at the provided interval
+// until the context is done
+func (s *UpstreamSet) Start(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		return
+	}
+	s.startOnce.Do(func() {
+		go func() {
+			ticker := time.NewTicker(interval)
+			defer ticker.Stop()
+			for {
+				s.CheckAll()
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+				}
+			}
+		}()
+	})
+}
+
+// CheckAll checks the health of all upstreams concurrently and updates their
+// state
+func (s *UpstreamSet) CheckAll() {
+	s.checkMutex.Lock()
+	defer s.checkMutex.Unlock()
+	var wg sync.WaitGroup
+	for _, u := range s.upstreams {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := s.checkFunc(u); err != nil {
+				u.markDown(err)
+				logging.GetLogger().Warn(
+					"upstream node health check failed",
+					"upstream", u.Name(),
+					"error", err,
+				)
+			}
+		}()
+	}
+	wg.Wait()
+	// Mark upstreams which are too far behind the best tip as lagging
+	var bestTip uint64
+	for _, u := range s.upstreams {
+		u.mu.Lock()
+		if u.state == UpstreamStateHealthy {
+			bestTip = max(bestTip, u.tipSlot)
+		}
+		u.mu.Unlock()
+	}
+	s.lastBestTip.Store(bestTip)
+	for _, u := range s.upstreams {
+		u.mu.Lock()
+		if u.state == UpstreamStateHealthy {
+			if bestTip-u.tipSlot > s.maxSlotLag {
+				u.state = UpstreamStateLagging
+			}
+		}
+		healthy := 0.0
+		if u.state == UpstreamStateHealthy {
+			healthy = 1
+		}
+		metricUpstreamHealthy.WithLabelValues(u.cfg.Name).Set(healthy)
+		u.mu.Unlock()
+	}
+}
+
+// checkUpstream performs a handshake with the upstream and fetches its tip
+func (s *UpstreamSet) checkUpstream(u *Upstream) error {
+	start := time.Now()
+	oConn, err := s.dialFunc(u.cfg, nil)
+	if err != nil {
+		return err
+	}
+	defer oConn.Close()
+	tip, err := oConn.ChainSync().Client.GetCurrentTip()
+	if err != nil {
+		return err
+	}
+	u.recordCheck(
+		tip.Point.Slot,
+		tip.BlockNumber,
+		tip.Point.Hash,
+		time.Since(start),
+	)
+	return nil
+}
@@ -0,0 +1,108 @@
+// Copyright 2025 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/blinklabs-io/cardano-node-api/internal/config"
+	ouroboros "github.com/blinklabs-io/gouroboros"
+)
+
+func testUpstreamSet(t *testing.T, tips map[string]uint64) *UpstreamSet {
+	t.Helper()
+
+	s := NewUpstreamSet(
+		[]config.UpstreamConfig{
+			{Name: "relay1", SocketPath: "/ipc/relay1.socket"},
+			{Name: "relay2", SocketPath: "/ipc/relay2.socket"},
+			{Name: "relay3", SocketPath: "/ipc/relay3.socket"},
+		},
+		100,
+	)
+	s.dialFunc = func(
+		cfg config.UpstreamConfig,
+		_ *ConnectionConfig,
+	) (*ouroboros.Connection, error) {
+		if _, ok := tips[cfg.Name]; !ok {
+			return nil, errors.New("connection refused")
+		}
+		return ouroboros.NewConnection()
+	}
+	s.checkFunc = func(u *Upstream) error {
+		tip, ok := tips[u.Name()]
+		if !ok {
+			return errors.New("connection refused")
+		}
+		u.recordCheck(tip, 0, nil, 0)
+		return nil
+	}
+	return s
+}
+
+func TestUpstreamSetRanksByHealthAndTip(t *testing.T) {
+	s := testUpstreamSet(
+		t,
+		map[string]uint64{
+			"relay1": 1000,
+			"relay2": 1200,
+		},
+	)
+	s.CheckAll()
+
+	ranked := s.Ranked()
+	names := []string{ranked[0].Name(), ranked[1].Name(), ranked[2].Name()}
+	want := []string{"relay2", "relay1", "relay3"}
+	for idx := range want {
+		if names[idx] != want[idx] {
+			t.Fatalf("ranked = %v, want %v", names, want)
+		}
+	}
+	statuses := s.Status()
+	if statuses[0].State != UpstreamStateLagging {
+		t.Fatalf("relay1 state = %s, want %s", statuses[0].State, UpstreamStateLagging)
+	}
+	if statuses[0].SlotLag != 200 {
+		t.Fatalf("relay1 slot lag = %d, want 200", statuses[0].SlotLag)
+	}
+	if !statuses[1].Preferred {
+		t.Fatal("expected relay2 to be preferred")
+	}
+	if statuses[2].State != UpstreamStateDown {
+		t.Fatalf("relay3 state = %s, want %s", statuses[2].State, UpstreamStateDown)
+	}
+}
+
+func TestUpstreamSetDialFailsOver(t *testing.T) {
+	s := testUpstreamSet(
+		t,
+		map[string]uint64{
+			"relay2": 1000,
+		},
+	)
+
+	oConn, upstream, err := s.Dial(nil)
+	if err != nil {
+		t.Fatalf("Dial() error = %v", err)
+	}
+	defer oConn.Close()
+	if upstream.Name() != "relay2" {
+		t.Fatalf("upstream = %s, want relay2", upstream.Name())
+	}
+	if s.upstreams[0].Healthy() {
+		t.Fatal("expected failed upstream to be marked down")
+	}
+}
@@ -0,0 +1,216 @@
+// Copyright 2025 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"bytes"
+	"cmp"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/blinklabs-io/gouroboros/ledger"
+	lcommon "github.com/blinklabs-io/gouroboros/ledger/common"
+	ocommon "github.com/blinklabs-io/gouroboros/protocol/common"
+	"github.com/blinklabs-io/gouroboros/protocol/localstatequery"
+)
+
+// ParseTxIn parses a transaction input in the form <tx hash>#<index>
+func ParseTxIn(txIn string) (ledger.ShelleyTransactionInput, error) {
+	hashHex, idxStr, ok := strings.Cut(txIn, "#")
+	if !ok {
+		return ledger.ShelleyTransactionInput{}, fmt.Errorf(
+			"invalid transaction input %q: expected <tx hash>#<index>",
+			txIn,
+		)
+	}
+	hash, err := hex.DecodeString(hashHex)
+	if err != nil {
+		return ledger.ShelleyTransactionInput{}, fmt.Errorf(
+			"invalid transaction hash %q: %w",
+			hashHex,
+			err,
+		)
+	}
+	if len(hash) != lcommon.Blake2b256Size {
+		return ledger.ShelleyTransactionInput{}, fmt.Errorf(
+			"invalid transaction hash %q: expected %d bytes",
+			hashHex,
+			lcommon.Blake2b256Size,
+		)
+	}
+	idx, err := strconv.ParseUint(idxStr, 10, 32)
+	if err != nil {
+		return ledger.ShelleyTransactionInput{}, fmt.Errorf(
+			"invalid output index %q: %w",
+			idxStr,
+			err,
+		)
+	}
+	return ledger.ShelleyTransactionInput{
+		TxId:        ledger.NewBlake2b256(hash),
+		OutputIndex: uint32(idx),
+	}, nil
+}
+
+// ParseAddress parses an address in bech32, base58 (Byron) or hex form
+func ParseAddress(addr string) (ledger.Address, error) {
+	if ret, err := ledger.NewAddress(addr); err == nil {
+		return ret, nil
+	}
+	addrBytes, err := hex.DecodeString(addr)
+	if err != nil {
+		return ledger.Address{}, fmt.Errorf("invalid address %q", addr)
+	}
+	ret, err := lcommon.NewAddressFromBytes(addrBytes)
+	if err != nil {
+		return ledger.Address{}, fmt.Errorf("invalid address %q: %w", addr, err)
+	}
+	return ret, nil
+}
+
+// UtxosByTxInAt resolves the provided transaction inputs against the UTxO set
+// as of the block at the provided point. The node only keeps the ledger
+// states of recent blocks, so this fails for older points. The acquired state
+// is kept until the client is released, which a pool lease does on release.
+func UtxosByTxInAt(
+	client *localstatequery.Client,
+	point ocommon.Point,
+	txIns []ledger.TransactionInput,
+) ([]ledger.Utxo, error) {
+	if len(txIns) == 0 {
+		return nil, nil
+	}
+	if err := client.Acquire(&point); err != nil {
+		return nil, fmt.Errorf("acquire ledger state: %w", err)
+	}
+	return UtxosByTxIn(client, txIns)
+}
+
+// UtxosByTxIn resolves the provided transaction inputs against the current
+// UTxO set. The results are returned in the order of the provided inputs, and
+// inputs which are not in the UTxO set are omitted.
+func UtxosByTxIn(
+	client *localstatequery.Client,
+	txIns []ledger.TransactionInput,
+) ([]ledger.Utxo, error) {
+	if len(txIns) == 0 {
+		return nil, nil
+	}
+	utxos, err := client.GetUTxOByTxIn(txIns)
+	if err != nil {
+		return nil, err
+	}
+	return utxoResultsByTxIn(utxos.Results, txIns), nil
+}
+
+// utxoResultsByTxIn matches query results back to the provided inputs by
+// transaction hash and index. The result keys also carry a datum hash, so
+// they can't be looked up directly
+func utxoResultsByTxIn(
+	results map[localstatequery.UtxoId]ledger.BabbageTransactionOutput,
+	txIns []ledger.TransactionInput,
+) []ledger.Utxo {
+	outputs := make(
+		map[utxoRef]ledger.BabbageTransactionOutput,
+		len(results),
+	)
+	for utxoId, output := range results {
+		outputs[utxoRef{hash: utxoId.Hash, idx: utxoId.Idx}] = output
+	}
+	ret := make([]ledger.Utxo, 0, len(txIns))
+	for _, txIn := range txIns {
+		output, ok := outputs[utxoRef{
+			hash: txIn.Id(),
+			idx:  int(txIn.Index()),
+		}]
+		if !ok {
+			continue
+		}
+		ret = append(
+			ret,
+			ledger.Utxo{
+				Id:     txIn,
+				Output: output,
+			},
+		)
+	}
+	return ret
+}
+
+// utxoRef identifies a UTxO by transaction hash and output index
+type utxoRef struct {
+	hash ledger.Blake2b256
+	idx  int
+}
+
+// UtxosByAddress returns the UTxOs at the provided addresses, ordered by
+// transaction hash and output index
+func UtxosByAddress(
+	client *localstatequery.Client,
+	addrs []ledger.Address,
+) ([]ledger.Utxo, error) {
+	if len(addrs) == 0 {
+		return nil, errors.New("no addresses provided")
+	}
+	utxos, err := client.GetUTxOByAddress(addrs)
+	if err != nil {
+		return nil, err
+	}
+	return utxoResultsToList(utxos.Results)
+}
+
+func utxoResultsToList(
+	results map[localstatequery.UtxoId]ledger.BabbageTransactionOutput,
+) ([]ledger.Utxo, error) {
+	ret := make([]ledger.Utxo, 0, len(results))
+	for utxoId, output := range results {
+		if utxoId.Idx < 0 || utxoId.Idx > math.MaxUint32 {
+			return nil, fmt.Errorf("invalid output index %d", utxoId.Idx)
+		}
+		ret = append(
+			ret,
+			ledger.Utxo{
+				Id: ledger.ShelleyTransactionInput{
+					TxId:        utxoId.Hash,
+					OutputIndex: uint32(utxoId.Idx),
+				},
+				Output: output,
+			},
+		)
+	}
+	SortUtxos(ret)
+	return ret, nil
+}
+
+// SortUtxos orders UTxOs by transaction hash and output index
+func SortUtxos(utxos []ledger.Utxo) {
+	slices.SortFunc(utxos, func(a, b ledger.Utxo) int {
+		return CompareTxIns(a.Id, b.Id)
+	})
+}
+
+// CompareTxIns compares transaction inputs by transaction hash and output
+// index
+func CompareTxIns(a, b ledger.TransactionInput) int {
+	if c := bytes.Compare(a.Id().Bytes(), b.Id().Bytes()); c != 0 {
+		return c
+	}
+	return cmp.Compare(a.Index(), b.Index())
+}
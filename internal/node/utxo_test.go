@@ -0,0 +1,182 @@
+// Copyright 2025 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/blinklabs-io/gouroboros/ledger"
+	lcommon "github.com/blinklabs-io/gouroboros/ledger/common"
+	"github.com/blinklabs-io/gouroboros/protocol/localstatequery"
+)
+
+const testTxHashHex = "9f6a2cd3c2a25e1f4a6ec0f8c8ec1e30d4a5e5d0b6a56cb4df0b7a6b2f23e8d1"
+
+func TestParseTxIn(t *testing.T) {
+	testDefs := []struct {
+		input   string
+		index   uint32
+		wantErr bool
+	}{
+		{input: testTxHashHex + "#0", index: 0},
+		{input: testTxHashHex + "#42", index: 42},
+		{input: testTxHashHex, wantErr: true},
+		{input: testTxHashHex + "#", wantErr: true},
+		{input: testTxHashHex + "#-1", wantErr: true},
+		{input: testTxHashHex + "#4294967296", wantErr: true},
+		{input: testTxHashHex[:62] + "#0", wantErr: true},
+		{input: "zz" + testTxHashHex[2:] + "#0", wantErr: true},
+	}
+	for _, testDef := range testDefs {
+		txIn, err := ParseTxIn(testDef.input)
+		if testDef.wantErr {
+			if err == nil {
+				t.Errorf("expected error for input %q", testDef.input)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("unexpected error for input %q: %s", testDef.input, err)
+			continue
+		}
+		if txIn.Id().String() != testTxHashHex {
+			t.Errorf("unexpected tx hash: %s", txIn.Id().String())
+		}
+		if txIn.Index() != testDef.index {
+			t.Errorf(
+				"unexpected index: got %d, expected %d",
+				txIn.Index(),
+				testDef.index,
+			)
+		}
+	}
+}
+
+func TestParseAddress(t *testing.T) {
+	addr, err := ledger.NewAddressFromParts(
+		ledger.AddressTypeKeyNone,
+		lcommon.AddressNetworkTestnet,
+		make([]byte, lcommon.AddressHashSize),
+		nil,
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	addrBytes, err := addr.Bytes()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	for _, input := range []string{
+		addr.String(),
+		hex.EncodeToString(addrBytes),
+		strings.ToUpper(hex.EncodeToString(addrBytes)),
+	} {
+		got, err := ParseAddress(input)
+		if err != nil {
+			t.Errorf("unexpected error for input %q: %s", input, err)
+			continue
+		}
+		if got.String() != addr.String() {
+			t.Errorf(
+				"unexpected address for input %q: got %s, expected %s",
+				input,
+				got.String(),
+				addr.String(),
+			)
+		}
+	}
+	for _, input := range []string{"", "addr_test1invalid", "zz"} {
+		if _, err := ParseAddress(input); err == nil {
+			t.Errorf("expected error for input %q", input)
+		}
+	}
+}
+
+func TestUtxoResultsToListSorted(t *testing.T) {
+	var hashA, hashB ledger.Blake2b256
+	hashA[31] = 1
+	hashB[31] = 2
+	results := map[localstatequery.UtxoId]ledger.BabbageTransactionOutput{
+		{Hash: hashB, Idx: 0}: {},
+		{Hash: hashA, Idx: 3}: {},
+		{Hash: hashA, Idx: 1}: {},
+	}
+	utxos, err := utxoResultsToList(results)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	expected := []struct {
+		hash  ledger.Blake2b256
+		index uint32
+	}{
+		{hashA, 1},
+		{hashA, 3},
+		{hashB, 0},
+	}
+	if len(utxos) != len(expected) {
+		t.Fatalf("expected %d UTxOs, got %d", len(expected), len(utxos))
+	}
+	for i, utxo := range utxos {
+		if utxo.Id.Id() != expected[i].hash ||
+			utxo.Id.Index() != expected[i].index {
+			t.Errorf(
+				"unexpected UTxO at position %d: %s#%d",
+				i,
+				utxo.Id.Id().String(),
+				utxo.Id.Index(),
+			)
+		}
+	}
+}
+
+func TestUtxoResultsToListInvalidIndex(t *testing.T) {
+	results := map[localstatequery.UtxoId]ledger.BabbageTransactionOutput{
+		{Idx: -1}: {},
+	}
+	if _, err := utxoResultsToList(results); err == nil {
+		t.Fatal("expected error for negative output index")
+	}
+}
+
+func TestUtxoResultsByTxIn(t *testing.T) {
+	var hashA, hashB, datumHash ledger.Blake2b256
+	hashA[31] = 1
+	hashB[31] = 2
+	datumHash[0] = 0xff
+	var outputA, outputB ledger.BabbageTransactionOutput
+	outputA.OutputAmount.Amount = 1
+	outputB.OutputAmount.Amount = 2
+	results := map[localstatequery.UtxoId]ledger.BabbageTransactionOutput{
+		{Hash: hashA, Idx: 0}:                       outputA,
+		{Hash: hashB, Idx: 1, DatumHash: datumHash}: outputB,
+	}
+	txIns := []ledger.TransactionInput{
+		ledger.ShelleyTransactionInput{TxId: hashB, OutputIndex: 1},
+		ledger.ShelleyTransactionInput{TxId: hashA, OutputIndex: 1},
+		ledger.ShelleyTransactionInput{TxId: hashA, OutputIndex: 0},
+	}
+	utxos := utxoResultsByTxIn(results, txIns)
+	if len(utxos) != 2 {
+		t.Fatalf("expected 2 UTxOs, got %d", len(utxos))
+	}
+	if utxos[0].Id != txIns[0] || utxos[0].Output.Amount().Uint64() != 2 {
+		t.Errorf("unexpected first UTxO: %s", utxos[0].Id.String())
+	}
+	if utxos[1].Id != txIns[2] || utxos[1].Output.Amount().Uint64() != 1 {
+		t.Errorf("unexpected second UTxO: %s", utxos[1].Id.String())
+	}
+}
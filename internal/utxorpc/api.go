@@ -30,6 +30,11 @@ import (
 	"github.com/utxorpc/go-codegen/utxorpc/v1alpha/watch/watchconnect"
 )
 
+// StreamUtxos isn't in the generated QueryService, so it's served by its own
+// handler, which takes precedence over the service handler for its path
+const streamUtxosProcedure = "/" + queryconnect.QueryServiceName +
+	"/StreamUtxos"
+
 func Start(cfg *config.Config) error {
 	// Standard logging
 	logger := logging.GetLogger()
@@ -65,12 +70,20 @@ func Start(cfg *config.Config) error {
 		compress1KB,
 	)
 	mux.Handle(queryPath, queryHandler)
+	mux.Handle(
+		streamUtxosProcedure,
+		connect.NewServerStreamHandler(
+			streamUtxosProcedure,
+			(&queryServiceServer{}).StreamUtxos,
+			compress1KB,
+		),
+	)
 	mux.Handle(submitPath, submitHandler)
 	mux.Handle(syncPath, syncHandler)
 	mux.Handle(watchPath, watchHandler)
 	mux.Handle(
 		grpchealth.NewHandler(
-			grpchealth.NewStaticChecker(
+			newHealthChecker(
 				queryconnect.QueryServiceName,
 				submitconnect.SubmitServiceName,
 				syncconnect.SyncServiceName,
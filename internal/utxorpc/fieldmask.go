@@ -0,0 +1,92 @@
+// Copyright 2025 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utxorpc
+
+import (
+	"fmt"
+	"strings"
+
+	connect "connectrpc.com/connect"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// fieldMaskTree is a field mask split into its path components. A node
+// without children selects the whole field.
+type fieldMaskTree map[string]fieldMaskTree
+
+func newFieldMaskTree(mask *fieldmaskpb.FieldMask) fieldMaskTree {
+	ret := fieldMaskTree{}
+	for _, path := range mask.GetPaths() {
+		node := ret
+		for name := range strings.SplitSeq(path, ".") {
+			child, ok := node[name]
+			if ok && len(child) == 0 {
+				// A parent path already selects this field
+				node = nil
+				break
+			}
+			if !ok {
+				child = fieldMaskTree{}
+				node[name] = child
+			}
+			node = child
+		}
+		// Selecting a field selects all of its sub-fields
+		clear(node)
+	}
+	return ret
+}
+
+func (t fieldMaskTree) prune(msg protoreflect.Message) {
+	var clearFields []protoreflect.FieldDescriptor
+	msg.Range(
+		func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+			child, ok := t[string(fd.Name())]
+			switch {
+			case !ok:
+				clearFields = append(clearFields, fd)
+			case len(child) > 0 && fd.Message() != nil && !fd.IsList() &&
+				!fd.IsMap():
+				child.prune(v.Message())
+			}
+			return true
+		},
+	)
+	for _, fd := range clearFields {
+		msg.Clear(fd)
+	}
+}
+
+// applyFieldMask clears the fields of msg which aren't selected by the field
+// mask. An empty field mask selects all fields.
+func applyFieldMask(msg proto.Message, mask *fieldmaskpb.FieldMask) error {
+	if len(mask.GetPaths()) == 0 {
+		return nil
+	}
+	if !mask.IsValid(msg) {
+		return connect.NewError(
+			connect.CodeInvalidArgument,
+			fmt.Errorf(
+				"invalid field mask %v for %s",
+				mask.GetPaths(),
+				msg.ProtoReflect().Descriptor().FullName(),
+			),
+		)
+	}
+	newFieldMaskTree(mask).prune(msg.ProtoReflect())
+	return nil
+}
@@ -0,0 +1,103 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utxorpc
+
+import (
+	"errors"
+	"testing"
+
+	connect "connectrpc.com/connect"
+	"github.com/utxorpc/go-codegen/utxorpc/v1alpha/cardano"
+	sync "github.com/utxorpc/go-codegen/utxorpc/v1alpha/sync"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+func newTestAnyChainBlock() *sync.AnyChainBlock {
+	return &sync.AnyChainBlock{
+		NativeBytes: []byte{0x01},
+		Chain: &sync.AnyChainBlock_Cardano{
+			Cardano: &cardano.Block{
+				Header: &cardano.BlockHeader{
+					Slot:   100,
+					Hash:   []byte{0x02},
+					Height: 10,
+				},
+				Body: &cardano.BlockBody{
+					Tx: []*cardano.Tx{{Hash: []byte{0x03}}},
+				},
+				Timestamp: 1000,
+			},
+		},
+	}
+}
+
+func TestApplyFieldMask(t *testing.T) {
+	// Empty masks select everything
+	acb := newTestAnyChainBlock()
+	if err := applyFieldMask(acb, nil); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if acb.GetNativeBytes() == nil || acb.GetCardano().GetBody() == nil {
+		t.Fatalf("unexpected pruning with empty mask: %v", acb)
+	}
+
+	acb = newTestAnyChainBlock()
+	err := applyFieldMask(
+		acb,
+		&fieldmaskpb.FieldMask{
+			Paths: []string{"cardano.header.slot", "cardano.body"},
+		},
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	block := acb.GetCardano()
+	if acb.GetNativeBytes() != nil || block.GetTimestamp() != 0 {
+		t.Fatalf("unselected fields not cleared: %v", acb)
+	}
+	if block.GetHeader().GetSlot() != 100 ||
+		block.GetHeader().GetHash() != nil ||
+		block.GetHeader().GetHeight() != 0 {
+		t.Fatalf("unexpected header: %v", block.GetHeader())
+	}
+	if len(block.GetBody().GetTx()) != 1 {
+		t.Fatalf("unexpected body: %v", block.GetBody())
+	}
+
+	// A parent path selects the whole field, regardless of order
+	acb = newTestAnyChainBlock()
+	err = applyFieldMask(
+		acb,
+		&fieldmaskpb.FieldMask{
+			Paths: []string{"cardano.header.slot", "cardano.header"},
+		},
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if acb.GetCardano().GetHeader().GetHash() == nil {
+		t.Fatalf("unexpected header: %v", acb.GetCardano().GetHeader())
+	}
+
+	err = applyFieldMask(
+		newTestAnyChainBlock(),
+		&fieldmaskpb.FieldMask{Paths: []string{"cardano.bogus"}},
+	)
+	var connectErr *connect.Error
+	if !errors.As(err, &connectErr) ||
+		connectErr.Code() != connect.CodeInvalidArgument {
+		t.Fatalf("expected invalid argument error, got: %v", err)
+	}
+}
@@ -0,0 +1,270 @@
+// Copyright 2025 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utxorpc
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/blinklabs-io/cardano-node-api/internal/node"
+	"github.com/blinklabs-io/gouroboros/ledger"
+	lcommon "github.com/blinklabs-io/gouroboros/ledger/common"
+	"github.com/blinklabs-io/gouroboros/protocol/localstatequery"
+	cardano "github.com/utxorpc/go-codegen/utxorpc/v1alpha/cardano"
+)
+
+// newCaip2 returns the CAIP-2 chain ID for a Cardano network, as defined by
+// CIP-34
+func newCaip2(networkId uint8, networkMagic uint32) string {
+	return fmt.Sprintf("cip34:%d-%d", networkId, networkMagic)
+}
+
+// newGenesis converts the Shelley genesis config from the node. The node
+// doesn't provide the Byron genesis or the genesis files for later eras, so
+// only the Shelley fields are set.
+func newGenesis(
+	genesisConfig *localstatequery.GenesisConfigResult,
+) (*cardano.Genesis, error) {
+	for _, val := range []int{
+		genesisConfig.SecurityParam,
+		genesisConfig.EpochLength,
+		genesisConfig.SlotsPerKESPeriod,
+		genesisConfig.MaxKESEvolutions,
+		genesisConfig.SlotLength,
+		genesisConfig.UpdateQuorum,
+	} {
+		if val < 0 || val > math.MaxUint32 {
+			return nil, fmt.Errorf(
+				"genesis config contains out of range value %d",
+				val,
+			)
+		}
+	}
+	if genesisConfig.NetworkMagic < 0 ||
+		genesisConfig.NetworkMagic > math.MaxUint32 {
+		return nil, errors.New("network magic int overflow")
+	}
+	if genesisConfig.MaxLovelaceSupply < 0 {
+		return nil, errors.New(
+			"genesis config contains negative max lovelace supply",
+		)
+	}
+	activeSlotsCoeff, err := newRationalNumberFromArray(
+		genesisConfig.ActiveSlotsCoeff,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("decode active slots coefficient: %w", err)
+	}
+	protocolParams, err := newGenesisProtocolParams(
+		genesisConfig.ProtocolParams,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("decode protocol params: %w", err)
+	}
+	networkId := "Testnet"
+	if genesisConfig.NetworkId == lcommon.AddressNetworkMainnet {
+		networkId = "Mainnet"
+	}
+	systemStartMs := node.SystemStartToUnixMs(&genesisConfig.Start)
+	// #nosec G115 -- values are range checked above
+	return &cardano.Genesis{
+		ActiveSlotsCoeff: activeSlotsCoeff,
+		EpochLength:      uint32(genesisConfig.EpochLength),
+		MaxKesEvolutions: uint32(genesisConfig.MaxKESEvolutions),
+		MaxLovelaceSupply: lcommon.ToUtxorpcBigInt(
+			uint64(genesisConfig.MaxLovelaceSupply),
+		),
+		NetworkId:      networkId,
+		NetworkMagic:   uint32(genesisConfig.NetworkMagic),
+		ProtocolParams: protocolParams,
+		SecurityParam:  uint32(genesisConfig.SecurityParam),
+		// The slot length is encoded in microseconds, but the genesis file
+		// uses seconds
+		SlotLength:        uint32(genesisConfig.SlotLength / 1000000),
+		SlotsPerKesPeriod: uint32(genesisConfig.SlotsPerKESPeriod),
+		SystemStart: time.UnixMilli(systemStartMs).UTC().Format(
+			time.RFC3339,
+		),
+		UpdateQuorum: uint32(genesisConfig.UpdateQuorum),
+	}, nil
+}
+
+// newGenesisProtocolParams converts the initial protocol params from the
+// Shelley genesis config
+func newGenesisProtocolParams(
+	pparams localstatequery.GenesisConfigResultProtocolParameters,
+) (*cardano.PParams, error) {
+	for _, val := range []int{
+		pparams.MinFeeA,
+		pparams.MinFeeB,
+		pparams.MaxBlockBodySize,
+		pparams.MaxTxSize,
+		pparams.MaxBlockHeaderSize,
+		pparams.KeyDeposit,
+		pparams.PoolDeposit,
+		pparams.EMax,
+		pparams.NOpt,
+		pparams.MinPoolCost,
+	} {
+		if val < 0 {
+			return nil, fmt.Errorf("negative protocol param value %d", val)
+		}
+	}
+	if pparams.ProtocolVersionMajor < 0 ||
+		pparams.ProtocolVersionMajor > math.MaxUint32 ||
+		pparams.ProtocolVersionMinor < 0 ||
+		pparams.ProtocolVersionMinor > math.MaxUint32 {
+		return nil, fmt.Errorf(
+			"protocol version out of range: %d.%d",
+			pparams.ProtocolVersionMajor,
+			pparams.ProtocolVersionMinor,
+		)
+	}
+	var rationals [3]*cardano.RationalNumber
+	for idx, val := range [][]int{pparams.A0, pparams.Rho, pparams.Tau} {
+		tmpVal := make([]any, len(val))
+		for i, item := range val {
+			tmpVal[i] = int64(item)
+		}
+		rational, err := newRationalNumberFromArray(tmpVal)
+		if err != nil {
+			return nil, err
+		}
+		rationals[idx] = rational
+	}
+	// #nosec G115 -- values are range checked above
+	return &cardano.PParams{
+		MinFeeCoefficient: lcommon.ToUtxorpcBigInt(
+			uint64(pparams.MinFeeA),
+		),
+		MinFeeConstant: lcommon.ToUtxorpcBigInt(
+			uint64(pparams.MinFeeB),
+		),
+		MaxBlockBodySize:   uint64(pparams.MaxBlockBodySize),
+		MaxTxSize:          uint64(pparams.MaxTxSize),
+		MaxBlockHeaderSize: uint64(pparams.MaxBlockHeaderSize),
+		StakeKeyDeposit: lcommon.ToUtxorpcBigInt(
+			uint64(pparams.KeyDeposit),
+		),
+		PoolDeposit: lcommon.ToUtxorpcBigInt(
+			uint64(pparams.PoolDeposit),
+		),
+		PoolRetirementEpochBound: uint64(pparams.EMax),
+		DesiredNumberOfPools:     uint64(pparams.NOpt),
+		PoolInfluence:            rationals[0],
+		MonetaryExpansion:        rationals[1],
+		TreasuryExpansion:        rationals[2],
+		MinPoolCost: lcommon.ToUtxorpcBigInt(
+			uint64(pparams.MinPoolCost),
+		),
+		ProtocolVersion: &cardano.ProtocolVersion{
+			Major: uint32(pparams.ProtocolVersionMajor),
+			Minor: uint32(pparams.ProtocolVersionMinor),
+		},
+	}, nil
+}
+
+// newRationalNumberFromArray converts a rational which was decoded as a
+// generic [numerator, denominator] array
+func newRationalNumberFromArray(val []any) (*cardano.RationalNumber, error) {
+	if len(val) != 2 {
+		return nil, fmt.Errorf(
+			"expected 2 elements for rational, got %d",
+			len(val),
+		)
+	}
+	var ret [2]*big.Int
+	for idx, item := range val {
+		switch v := item.(type) {
+		case uint64:
+			ret[idx] = new(big.Int).SetUint64(v)
+		case int64:
+			ret[idx] = big.NewInt(v)
+		case *big.Int:
+			ret[idx] = v
+		default:
+			return nil, fmt.Errorf(
+				"unexpected rational value type %T",
+				item,
+			)
+		}
+	}
+	if !ret[0].IsInt64() || ret[0].Int64() < math.MinInt32 ||
+		ret[0].Int64() > math.MaxInt32 {
+		return nil, fmt.Errorf("rational numerator %s out of range", ret[0])
+	}
+	if !ret[1].IsUint64() || ret[1].Uint64() > math.MaxUint32 {
+		return nil, fmt.Errorf("rational denominator %s out of range", ret[1])
+	}
+	return &cardano.RationalNumber{
+		Numerator:   int32(ret[0].Int64()),   // #nosec G115
+		Denominator: uint32(ret[1].Uint64()), // #nosec G115
+	}, nil
+}
+
+// newEraSummaries converts the era summaries from the node's era history. The
+// provided protocol params are set on the current era, which is the last.
+func newEraSummaries(
+	summaries []node.EraSummary,
+	pparams *cardano.PParams,
+) (*cardano.EraSummaries, error) {
+	ret := &cardano.EraSummaries{
+		Summaries: make([]*cardano.EraSummary, 0, len(summaries)),
+	}
+	for idx, summary := range summaries {
+		era := ledger.GetEraById(summary.EraId)
+		if era == ledger.EraInvalid {
+			return nil, fmt.Errorf("unknown era ID %d", summary.EraId)
+		}
+		start, err := newEraBoundary(summary.Begin)
+		if err != nil {
+			return nil, err
+		}
+		tmpSummary := &cardano.EraSummary{
+			Name:  strings.ToLower(era.Name),
+			Start: start,
+		}
+		if summary.End != nil {
+			end, err := newEraBoundary(*summary.End)
+			if err != nil {
+				return nil, err
+			}
+			tmpSummary.End = end
+		}
+		if idx == len(summaries)-1 {
+			tmpSummary.ProtocolParams = pparams
+		}
+		ret.Summaries = append(ret.Summaries, tmpSummary)
+	}
+	return ret, nil
+}
+
+func newEraBoundary(bound node.EraBound) (*cardano.EraBoundary, error) {
+	if bound.POSIXTime < 0 {
+		return nil, fmt.Errorf(
+			"era boundary at slot %d is before the Unix epoch",
+			bound.Slot,
+		)
+	}
+	return &cardano.EraBoundary{
+		Time:  uint64(bound.POSIXTime),
+		Slot:  bound.Slot,
+		Epoch: bound.Epoch,
+	}, nil
+}
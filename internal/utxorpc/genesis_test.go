@@ -0,0 +1,146 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utxorpc
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/blinklabs-io/cardano-node-api/internal/node"
+	"github.com/blinklabs-io/gouroboros/protocol/localstatequery"
+	cardano "github.com/utxorpc/go-codegen/utxorpc/v1alpha/cardano"
+)
+
+func TestNewGenesis(t *testing.T) {
+	genesisConfig := &localstatequery.GenesisConfigResult{
+		NetworkMagic:      2,
+		NetworkId:         0,
+		ActiveSlotsCoeff:  []any{uint64(1), uint64(20)},
+		SecurityParam:     432,
+		EpochLength:       86400,
+		SlotsPerKESPeriod: 129600,
+		MaxKESEvolutions:  62,
+		SlotLength:        1000000,
+		UpdateQuorum:      5,
+		MaxLovelaceSupply: 45000000000000000,
+		ProtocolParams: localstatequery.GenesisConfigResultProtocolParameters{
+			MinFeeA:              44,
+			MinFeeB:              155381,
+			A0:                   []int{3, 10},
+			Rho:                  []int{3, 1000},
+			Tau:                  []int{1, 5},
+			ProtocolVersionMajor: 6,
+		},
+	}
+	genesisConfig.Start.Year.SetInt64(2022)
+	genesisConfig.Start.Day = 305
+
+	genesis, err := newGenesis(genesisConfig)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if genesis.GetNetworkId() != "Testnet" ||
+		genesis.GetNetworkMagic() != 2 ||
+		genesis.GetSlotLength() != 1 ||
+		genesis.GetEpochLength() != 86400 ||
+		genesis.GetSystemStart() != "2022-11-01T00:00:00Z" {
+		t.Fatalf("unexpected genesis: %v", genesis)
+	}
+	coeff := genesis.GetActiveSlotsCoeff()
+	if coeff.GetNumerator() != 1 || coeff.GetDenominator() != 20 {
+		t.Fatalf("unexpected active slots coefficient: %v", coeff)
+	}
+	pparams := genesis.GetProtocolParams()
+	if pparams.GetMinFeeCoefficient().GetInt() != 44 ||
+		pparams.GetPoolInfluence().GetDenominator() != 10 ||
+		pparams.GetProtocolVersion().GetMajor() != 6 {
+		t.Fatalf("unexpected protocol params: %v", pparams)
+	}
+	if caip2 := newCaip2(0, 2); caip2 != "cip34:0-2" {
+		t.Fatalf("unexpected CAIP-2 ID: %s", caip2)
+	}
+}
+
+func TestNewRationalNumberFromArray(t *testing.T) {
+	testDefs := []struct {
+		val     []any
+		wantErr bool
+	}{
+		{val: []any{uint64(1), uint64(20)}},
+		{val: []any{int64(-1), big.NewInt(2)}},
+		{val: []any{uint64(1)}, wantErr: true},
+		{val: []any{"1", uint64(2)}, wantErr: true},
+		{val: []any{uint64(1 << 40), uint64(2)}, wantErr: true},
+		{val: []any{uint64(1), int64(-2)}, wantErr: true},
+	}
+	for _, testDef := range testDefs {
+		_, err := newRationalNumberFromArray(testDef.val)
+		if (err != nil) != testDef.wantErr {
+			t.Fatalf("unexpected result for %v: %v", testDef.val, err)
+		}
+	}
+}
+
+func TestNewEraSummaries(t *testing.T) {
+	pparams := &cardano.PParams{MaxTxSize: 16384}
+	summaries, err := newEraSummaries(
+		[]node.EraSummary{
+			{
+				EraId: 0,
+				Begin: node.EraBound{POSIXTime: 1000},
+				End: &node.EraBound{
+					Slot:      86400,
+					Epoch:     4,
+					POSIXTime: 1728001000,
+				},
+			},
+			{
+				EraId: 1,
+				Begin: node.EraBound{
+					Slot:      86400,
+					Epoch:     4,
+					POSIXTime: 1728001000,
+				},
+			},
+		},
+		pparams,
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	got := summaries.GetSummaries()
+	if len(got) != 2 {
+		t.Fatalf("unexpected era summaries: %v", got)
+	}
+	if got[0].GetName() != "byron" ||
+		got[0].GetEnd().GetSlot() != 86400 ||
+		got[0].GetProtocolParams() != nil {
+		t.Fatalf("unexpected Byron summary: %v", got[0])
+	}
+	if got[1].GetName() != "shelley" ||
+		got[1].GetStart().GetTime() != 1728001000 ||
+		got[1].GetEnd() != nil ||
+		got[1].GetProtocolParams() != pparams {
+		t.Fatalf("unexpected Shelley summary: %v", got[1])
+	}
+
+	_, err = newEraSummaries(
+		[]node.EraSummary{{EraId: 200}},
+		pparams,
+	)
+	if err == nil {
+		t.Fatalf("expected error for unknown era")
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright 2025 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utxorpc
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"connectrpc.com/connect"
+	"connectrpc.com/grpchealth"
+	"github.com/blinklabs-io/cardano-node-api/internal/node"
+)
+
+// Service names for reporting liveness and readiness separately. The empty
+// service name and the UTxO RPC service names report readiness.
+const (
+	healthServiceLiveness  = "liveness"
+	healthServiceReadiness = "readiness"
+)
+
+// healthChecker reports the health of the node connection via the gRPC
+// health service
+type healthChecker struct {
+	services []string
+}
+
+func newHealthChecker(services ...string) *healthChecker {
+	return &healthChecker{
+		services: services,
+	}
+}
+
+func (h *healthChecker) Check(
+	ctx context.Context,
+	req *grpchealth.CheckRequest,
+) (*grpchealth.CheckResponse, error) {
+	var liveness bool
+	switch {
+	case req.Service == healthServiceLiveness:
+		liveness = true
+	case req.Service == "",
+		req.Service == healthServiceReadiness,
+		slices.Contains(h.services, req.Service):
+	default:
+		return nil, connect.NewError(
+			connect.CodeNotFound,
+			fmt.Errorf("unknown service %s", req.Service),
+		)
+	}
+	status := node.GetHealthChecker().Check(ctx)
+	ok := status.Ready
+	if liveness {
+		ok = status.Live
+	}
+	if !ok {
+		return &grpchealth.CheckResponse{
+			Status: grpchealth.StatusNotServing,
+		}, nil
+	}
+	return &grpchealth.CheckResponse{
+		Status: grpchealth.StatusServing,
+	}, nil
+}
@@ -0,0 +1,77 @@
+// Copyright 2025 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utxorpc
+
+import (
+	"bytes"
+	"slices"
+
+	ocommon "github.com/blinklabs-io/gouroboros/protocol/common"
+)
+
+// Number of applied blocks remembered by streams so that rollbacks can be
+// reported as Undo actions. Deeper rollbacks are reported with a Reset.
+const rollbackHistorySize = 100
+
+// blockHistory keeps what a stream sent for its most recently applied
+// blocks, keyed by block point
+type blockHistory[T any] struct {
+	size    int
+	entries []blockHistoryEntry[T]
+}
+
+type blockHistoryEntry[T any] struct {
+	point ocommon.Point
+	value T
+}
+
+func newBlockHistory[T any](size int) *blockHistory[T] {
+	return &blockHistory[T]{
+		size: size,
+	}
+}
+
+func (h *blockHistory[T]) push(point ocommon.Point, value T) {
+	h.entries = append(
+		h.entries,
+		blockHistoryEntry[T]{point: point, value: value},
+	)
+	if len(h.entries) > h.size {
+		h.entries = slices.Delete(h.entries, 0, len(h.entries)-h.size)
+	}
+}
+
+// rollback removes and returns the values for the blocks after the provided
+// point, newest first. It returns false if the point isn't in the history, in
+// which case the history is cleared.
+func (h *blockHistory[T]) rollback(point ocommon.Point) ([]T, bool) {
+	idx := slices.IndexFunc(
+		h.entries,
+		func(entry blockHistoryEntry[T]) bool {
+			return entry.point.Slot == point.Slot &&
+				bytes.Equal(entry.point.Hash, point.Hash)
+		},
+	)
+	if idx < 0 {
+		h.entries = nil
+		return nil, false
+	}
+	ret := make([]T, 0, len(h.entries)-idx-1)
+	for i := len(h.entries) - 1; i > idx; i-- {
+		ret = append(ret, h.entries[i].value)
+	}
+	h.entries = h.entries[:idx+1]
+	return ret, true
+}
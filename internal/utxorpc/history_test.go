@@ -0,0 +1,63 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utxorpc
+
+import (
+	"slices"
+	"testing"
+
+	ocommon "github.com/blinklabs-io/gouroboros/protocol/common"
+)
+
+func testHistoryPoint(slot uint64) ocommon.Point {
+	return ocommon.NewPoint(slot, []byte{byte(slot)})
+}
+
+func TestBlockHistoryRollback(t *testing.T) {
+	history := newBlockHistory[uint64](3)
+	for slot := uint64(1); slot <= 4; slot++ {
+		history.push(testHistoryPoint(slot), slot)
+	}
+
+	// Rolling back to the latest block undoes nothing
+	got, ok := history.rollback(testHistoryPoint(4))
+	if !ok || len(got) != 0 {
+		t.Fatalf("unexpected rollback result: %v, %v", got, ok)
+	}
+
+	// Blocks after the rollback point are returned newest first
+	got, ok = history.rollback(testHistoryPoint(2))
+	if !ok || !slices.Equal(got, []uint64{4, 3}) {
+		t.Fatalf("unexpected rollback result: %v, %v", got, ok)
+	}
+	history.push(testHistoryPoint(5), 5)
+	got, ok = history.rollback(testHistoryPoint(2))
+	if !ok || !slices.Equal(got, []uint64{5}) {
+		t.Fatalf("unexpected rollback result: %v, %v", got, ok)
+	}
+
+	// The oldest block was dropped from the history when it was full
+	if _, ok := history.rollback(testHistoryPoint(1)); ok {
+		t.Fatal("expected rollback past the history to fail")
+	}
+	// A point with a different hash is on another fork
+	history.push(testHistoryPoint(6), 6)
+	if _, ok := history.rollback(ocommon.NewPoint(6, []byte{0xff})); ok {
+		t.Fatal("expected rollback to an unknown point to fail")
+	}
+	if len(history.entries) != 0 {
+		t.Fatalf("expected history to be cleared: %v", history.entries)
+	}
+}
@@ -0,0 +1,520 @@
+// Copyright 2025 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utxorpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	connect "connectrpc.com/connect"
+	"github.com/blinklabs-io/cardano-node-api/internal/node"
+	"github.com/blinklabs-io/gouroboros/ledger"
+	lcommon "github.com/blinklabs-io/gouroboros/ledger/common"
+	"github.com/blinklabs-io/gouroboros/protocol/localstatequery"
+	"github.com/blinklabs-io/gouroboros/protocol/localtxmonitor"
+	submit "github.com/utxorpc/go-codegen/utxorpc/v1alpha/submit"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+const (
+	// Interval between the mempool snapshots taken for WatchMempool streams
+	watchMempoolPollInterval = time.Second
+	// Number of mempool transaction outputs remembered for resolving the
+	// inputs of chained transactions
+	watchMempoolOutputCacheSize = 10000
+	// Number of polls a WatchMempool stream can fall behind before it's
+	// ended
+	watchMempoolBacklog = 10
+	// How long a transaction which left the mempool can go without being
+	// found on the chain before it's reported as dropped. This covers the
+	// time between the node removing it from the mempool and chain-sync
+	// delivering the block which includes it.
+	watchMempoolDropTimeout = 30 * time.Second
+)
+
+// mempoolTx is a decoded transaction from a mempool snapshot
+type mempoolTx struct {
+	hash        string
+	nativeBytes []byte
+	tx          ledger.Transaction
+}
+
+// readMempool returns the transactions in a new mempool snapshot, in mempool
+// order
+func readMempool(client *localtxmonitor.Client) ([]mempoolTx, error) {
+	var ret []mempoolTx
+	for {
+		txRawBytes, err := client.NextTx()
+		if err != nil {
+			return nil, err
+		}
+		// End of the mempool snapshot
+		if txRawBytes == nil {
+			break
+		}
+		txType, err := ledger.DetermineTransactionType(txRawBytes)
+		if err != nil {
+			return nil, err
+		}
+		tx, err := ledger.NewTransactionFromCbor(txType, txRawBytes)
+		if err != nil {
+			return nil, err
+		}
+		ret = append(
+			ret,
+			mempoolTx{
+				hash:        tx.Hash().String(),
+				nativeBytes: txRawBytes,
+				tx:          tx,
+			},
+		)
+	}
+	return ret, nil
+}
+
+// mempoolPoller takes the mempool snapshots for all WatchMempool streams, so
+// that the node is polled once per interval however many streams are open.
+// It runs while any stream is watching.
+type mempoolPoller struct {
+	mu       sync.Mutex
+	watchers map[*mempoolWatcher]struct{}
+	stop     context.CancelFunc
+}
+
+var globalMempoolPoller = newMempoolPoller()
+
+func newMempoolPoller() *mempoolPoller {
+	return &mempoolPoller{
+		watchers: make(map[*mempoolWatcher]struct{}),
+	}
+}
+
+// mempoolWatcher is the subscription of a WatchMempool stream to the poller
+type mempoolWatcher struct {
+	predicate *txPredicate
+	fieldMask *fieldmaskpb.FieldMask
+	// Whether the watcher has been sent the transactions already in the
+	// mempool when it subscribed
+	primed bool
+	// Hashes of the matching transactions which haven't settled yet
+	matched map[string]bool
+	// Records for each poll, which is closed when the subscription ends
+	recordChan chan []*submit.TxInMempool
+	// The reason the subscription ended, set before recordChan is closed
+	err error
+}
+
+func newMempoolWatcher(
+	predicate *txPredicate,
+	fieldMask *fieldmaskpb.FieldMask,
+) *mempoolWatcher {
+	return &mempoolWatcher{
+		predicate:  predicate,
+		fieldMask:  fieldMask,
+		matched:    make(map[string]bool),
+		recordChan: make(chan []*submit.TxInMempool, watchMempoolBacklog),
+	}
+}
+
+// subscribe adds a watcher for the provided predicate, starting the poller if
+// it isn't running
+func (p *mempoolPoller) subscribe(
+	predicate *txPredicate,
+	fieldMask *fieldmaskpb.FieldMask,
+) *mempoolWatcher {
+	w := newMempoolWatcher(predicate, fieldMask)
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.watchers[w] = struct{}{}
+	if p.stop == nil {
+		ctx, cancel := context.WithCancel(context.Background())
+		p.stop = cancel
+		go p.run(ctx)
+	}
+	return w
+}
+
+// unsubscribe removes a watcher, stopping the poller if it was the last one
+func (p *mempoolPoller) unsubscribe(w *mempoolWatcher) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	delete(p.watchers, w)
+	p.stopIfIdle()
+}
+
+func (p *mempoolPoller) stopIfIdle() {
+	if len(p.watchers) == 0 && p.stop != nil {
+		p.stop()
+		p.stop = nil
+	}
+}
+
+func (p *mempoolPoller) run(ctx context.Context) {
+	state := newMempoolState()
+	ticker := time.NewTicker(watchMempoolPollInterval)
+	defer ticker.Stop()
+	for {
+		watchers := p.current(ctx)
+		if len(watchers) > 0 {
+			records, err := state.poll(ctx, watchers)
+			p.deliver(watchers, records, err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// current returns the watchers to poll for, unless the poller was stopped
+func (p *mempoolPoller) current(ctx context.Context) []*mempoolWatcher {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if ctx.Err() != nil {
+		return nil
+	}
+	ret := make([]*mempoolWatcher, 0, len(p.watchers))
+	for w := range p.watchers {
+		ret = append(ret, w)
+	}
+	return ret
+}
+
+// deliver sends the records from a poll to the watchers which are still
+// subscribed. A failed poll ends their subscriptions, as does falling too far
+// behind, so that a slow stream can't hold up the others.
+func (p *mempoolPoller) deliver(
+	watchers []*mempoolWatcher,
+	records map[*mempoolWatcher][]*submit.TxInMempool,
+	err error,
+) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for _, w := range watchers {
+		if _, ok := p.watchers[w]; !ok {
+			continue
+		}
+		if err != nil {
+			p.end(w, err)
+			continue
+		}
+		if len(records[w]) == 0 {
+			continue
+		}
+		select {
+		case w.recordChan <- records[w]:
+		default:
+			p.end(
+				w,
+				connect.NewError(
+					connect.CodeResourceExhausted,
+					errors.New("stream fell behind the mempool"),
+				),
+			)
+		}
+	}
+	p.stopIfIdle()
+}
+
+func (p *mempoolPoller) end(w *mempoolWatcher, err error) {
+	delete(p.watchers, w)
+	w.err = err
+	close(w.recordChan)
+}
+
+// mempoolState is what a running poller knows about the mempool
+type mempoolState struct {
+	// Transactions in the previous snapshot, by hash
+	seen map[string]*mempoolTx
+	// Outputs of mempool transactions, which chained transactions may spend
+	// before they reach the ledger
+	outputs *outputCache
+	// Transactions which matched for any watcher and left the mempool, but
+	// haven't been found on the chain yet
+	departed []departedTx
+}
+
+// departedTx is a matching transaction which left the mempool
+type departedTx struct {
+	mt    *mempoolTx
+	since time.Time
+}
+
+// settledTx is a departed transaction which was confirmed or dropped
+type settledTx struct {
+	mt    *mempoolTx
+	stage submit.Stage
+}
+
+func newMempoolState() *mempoolState {
+	return &mempoolState{
+		seen:    make(map[string]*mempoolTx),
+		outputs: newOutputCache(watchMempoolOutputCacheSize),
+	}
+}
+
+// diff records a new snapshot, returning the transactions which weren't in
+// the previous snapshot and the transactions which have left the mempool
+// since
+func (s *mempoolState) diff(
+	snapshot []mempoolTx,
+) ([]mempoolTx, []*mempoolTx) {
+	var added []mempoolTx
+	seen := make(map[string]*mempoolTx, len(snapshot))
+	for _, mt := range snapshot {
+		if _, ok := s.seen[mt.hash]; !ok {
+			added = append(added, mt)
+		}
+		seen[mt.hash] = &mt
+	}
+	var removed []*mempoolTx
+	for hash, mt := range s.seen {
+		if _, ok := seen[hash]; !ok {
+			removed = append(removed, mt)
+		}
+	}
+	s.seen = seen
+	return added, removed
+}
+
+// poll takes a new mempool snapshot and returns the records to send to each
+// watcher for the matching transactions which entered or left the mempool
+// since the previous snapshot. Watchers which haven't been primed are sent
+// all matching transactions in the snapshot instead of only the new ones.
+func (s *mempoolState) poll(
+	ctx context.Context,
+	watchers []*mempoolWatcher,
+) (map[*mempoolWatcher][]*submit.TxInMempool, error) {
+	// Lease a node connection from the pool
+	lease, err := node.AcquireConnection(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer lease.Release()
+
+	snapshot, err := readMempool(lease.LocalTxMonitor())
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	added, removed := s.diff(snapshot)
+	// Only transactions which matched for a watcher are followed once they
+	// leave the mempool
+	removed = slices.DeleteFunc(removed, func(mt *mempoolTx) bool {
+		return !slices.ContainsFunc(watchers, func(w *mempoolWatcher) bool {
+			return w.matched[mt.hash]
+		})
+	})
+	s.depart(removed, added, now)
+
+	// Resolve the inputs of the transactions to evaluate which don't spend
+	// the outputs of other mempool transactions. Spent outputs are left in
+	// the cache, as the transactions spending them may not have been
+	// evaluated yet.
+	for _, mt := range added {
+		s.outputs.add(mt.tx)
+	}
+	var evaluated []mempoolTx
+	for _, w := range watchers {
+		if !w.predicate.resolvesInputs() {
+			continue
+		}
+		if !w.primed {
+			evaluated = snapshot
+			break
+		}
+		evaluated = added
+	}
+	var txIns []ledger.TransactionInput
+	for _, mt := range evaluated {
+		for _, input := range slices.Concat(
+			mt.tx.Inputs(),
+			mt.tx.Collateral(),
+		) {
+			if s.outputs.resolve(input) == nil {
+				txIns = append(txIns, input)
+			}
+		}
+	}
+	utxos, err := node.UtxosByTxIn(lease.LocalStateQuery(), txIns)
+	if err != nil {
+		return nil, fmt.Errorf("resolve inputs: %w", err)
+	}
+	ledgerOutputs := make(map[string]lcommon.TransactionOutput, len(utxos))
+	for _, utxo := range utxos {
+		ledgerOutputs[outputCacheKey(utxo.Id)] = utxo.Output
+	}
+	resolve := func(input lcommon.TransactionInput) lcommon.TransactionOutput {
+		if output := s.outputs.resolve(input); output != nil {
+			return output
+		}
+		return ledgerOutputs[outputCacheKey(input)]
+	}
+
+	ret := make(map[*mempoolWatcher][]*submit.TxInMempool, len(watchers))
+	for _, w := range watchers {
+		txs := added
+		if !w.primed {
+			txs = snapshot
+			w.primed = true
+		}
+		for _, mt := range txs {
+			if !w.predicate.matches(mt.tx, resolve) {
+				continue
+			}
+			record, err := newTxInMempool(
+				mt,
+				submit.Stage_STAGE_MEMPOOL,
+				w.fieldMask,
+			)
+			if err != nil {
+				return nil, err
+			}
+			ret[w] = append(ret[w], record)
+			w.matched[mt.hash] = true
+		}
+	}
+
+	// Transactions leave the mempool when they're added to a block or when
+	// they become invalid
+	included, err := s.included(lease.LocalStateQuery())
+	if err != nil {
+		return nil, err
+	}
+	for _, settled := range s.settle(included, now) {
+		for _, w := range watchers {
+			if !w.matched[settled.mt.hash] {
+				continue
+			}
+			delete(w.matched, settled.mt.hash)
+			record, err := newTxInMempool(
+				*settled.mt,
+				settled.stage,
+				w.fieldMask,
+			)
+			if err != nil {
+				return nil, err
+			}
+			ret[w] = append(ret[w], record)
+		}
+	}
+	return ret, nil
+}
+
+// depart records the matching transactions which left the mempool, and
+// forgets those which came back
+func (s *mempoolState) depart(
+	removed []*mempoolTx,
+	added []mempoolTx,
+	now time.Time,
+) {
+	s.departed = slices.DeleteFunc(s.departed, func(d departedTx) bool {
+		return slices.ContainsFunc(added, func(mt mempoolTx) bool {
+			return mt.hash == d.mt.hash
+		})
+	})
+	for _, mt := range removed {
+		s.departed = append(s.departed, departedTx{mt: mt, since: now})
+	}
+}
+
+// included returns the hashes of the departed transactions which are known
+// to be on the chain, either because chain-sync delivered a block with them
+// or because any of their outputs made it to the ledger. Outputs which have
+// already been spent, such as by chained transactions, can't tell us anything.
+func (s *mempoolState) included(
+	client *localstatequery.Client,
+) (map[string]bool, error) {
+	ret := make(map[string]bool)
+	var produced []ledger.TransactionInput
+	for _, d := range s.departed {
+		if _, ok := node.LookupTx(d.mt.tx.Hash()); ok {
+			ret[d.mt.hash] = true
+			continue
+		}
+		for _, utxo := range d.mt.tx.Produced() {
+			produced = append(produced, utxo.Id)
+		}
+	}
+	utxos, err := node.UtxosByTxIn(client, produced)
+	if err != nil {
+		return nil, fmt.Errorf("resolve outputs: %w", err)
+	}
+	for _, utxo := range utxos {
+		ret[utxo.Id.Id().String()] = true
+	}
+	return ret, nil
+}
+
+// settle returns the departed transactions which are now confirmed, or which
+// have been missing from the chain for long enough to be considered dropped.
+// The others are kept for the next poll.
+func (s *mempoolState) settle(
+	included map[string]bool,
+	now time.Time,
+) []settledTx {
+	var ret []settledTx
+	s.departed = slices.DeleteFunc(s.departed, func(d departedTx) bool {
+		switch {
+		case included[d.mt.hash]:
+			ret = append(
+				ret,
+				settledTx{mt: d.mt, stage: submit.Stage_STAGE_CONFIRMED},
+			)
+		case now.Sub(d.since) >= watchMempoolDropTimeout:
+			// The stage enum has no value for dropped transactions, so
+			// STAGE_UNSPECIFIED is sent to mean that the transaction left
+			// the mempool without making it to the chain
+			ret = append(
+				ret,
+				settledTx{mt: d.mt, stage: submit.Stage_STAGE_UNSPECIFIED},
+			)
+		default:
+			return false
+		}
+		return true
+	})
+	return ret
+}
+
+// newTxInMempool returns the record for a mempool transaction at the provided
+// stage, with the field mask applied
+func newTxInMempool(
+	mt mempoolTx,
+	stage submit.Stage,
+	fieldMask *fieldmaskpb.FieldMask,
+) (*submit.TxInMempool, error) {
+	cTx, err := mt.tx.Utxorpc() // *cardano.Tx
+	if err != nil {
+		return nil, fmt.Errorf("convert transaction: %w", err)
+	}
+	ret := &submit.TxInMempool{
+		Ref:         mt.tx.Hash().Bytes(),
+		NativeBytes: mt.nativeBytes,
+		Stage:       stage,
+		ParsedState: &submit.TxInMempool_Cardano{
+			Cardano: cTx,
+		},
+	}
+	if err := applyFieldMask(ret, fieldMask); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
@@ -0,0 +1,160 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utxorpc
+
+import (
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	connect "connectrpc.com/connect"
+	submit "github.com/utxorpc/go-codegen/utxorpc/v1alpha/submit"
+)
+
+func testMempoolSnapshot(hashes ...string) []mempoolTx {
+	ret := make([]mempoolTx, 0, len(hashes))
+	for _, hash := range hashes {
+		ret = append(ret, mempoolTx{hash: hash})
+	}
+	return ret
+}
+
+func mempoolTxHashes(txs []mempoolTx) []string {
+	ret := make([]string, 0, len(txs))
+	for _, mt := range txs {
+		ret = append(ret, mt.hash)
+	}
+	return ret
+}
+
+func TestMempoolStateDiff(t *testing.T) {
+	state := newMempoolState()
+	testDefs := []struct {
+		snapshot        []mempoolTx
+		expectedAdded   []string
+		expectedRemoved []string
+	}{
+		{
+			snapshot:      testMempoolSnapshot("a", "b"),
+			expectedAdded: []string{"a", "b"},
+		},
+		{
+			snapshot:        testMempoolSnapshot("b", "c"),
+			expectedAdded:   []string{"c"},
+			expectedRemoved: []string{"a"},
+		},
+		{
+			snapshot:        testMempoolSnapshot("c"),
+			expectedRemoved: []string{"b"},
+		},
+		{
+			// Transactions are reported again when they reappear
+			snapshot:      testMempoolSnapshot("a", "c"),
+			expectedAdded: []string{"a"},
+		},
+		{
+			snapshot: testMempoolSnapshot("a", "c"),
+		},
+	}
+	for idx, testDef := range testDefs {
+		added, removed := state.diff(testDef.snapshot)
+		var removedHashes []string
+		for _, mt := range removed {
+			removedHashes = append(removedHashes, mt.hash)
+		}
+		if !slices.Equal(mempoolTxHashes(added), testDef.expectedAdded) {
+			t.Fatalf(
+				"unexpected added transactions for snapshot %d: %v",
+				idx,
+				mempoolTxHashes(added),
+			)
+		}
+		if !slices.Equal(removedHashes, testDef.expectedRemoved) {
+			t.Fatalf(
+				"unexpected removed transactions for snapshot %d: %v",
+				idx,
+				removedHashes,
+			)
+		}
+	}
+}
+
+func TestMempoolStateSettle(t *testing.T) {
+	state := newMempoolState()
+	now := time.Now()
+	removed := []*mempoolTx{{hash: "a"}, {hash: "b"}, {hash: "c"}}
+	state.depart(removed, nil, now)
+
+	// Confirmed transactions are settled straight away, and the others wait
+	// for the drop timeout
+	settled := state.settle(map[string]bool{"a": true}, now)
+	if len(settled) != 1 || settled[0].mt.hash != "a" ||
+		settled[0].stage != submit.Stage_STAGE_CONFIRMED {
+		t.Fatalf("unexpected settled transactions: %v", settled)
+	}
+
+	// Transactions which come back to the mempool are no longer departed
+	state.depart(nil, testMempoolSnapshot("b"), now)
+	settled = state.settle(nil, now.Add(watchMempoolDropTimeout))
+	if len(settled) != 1 || settled[0].mt.hash != "c" ||
+		settled[0].stage != submit.Stage_STAGE_UNSPECIFIED {
+		t.Fatalf("unexpected settled transactions: %v", settled)
+	}
+	if len(state.departed) != 0 {
+		t.Fatalf("unexpected departed transactions: %v", state.departed)
+	}
+}
+
+func TestMempoolPollerDeliver(t *testing.T) {
+	poller := newMempoolPoller()
+	slow := newMempoolWatcher(nil, nil)
+	fast := newMempoolWatcher(nil, nil)
+	poller.watchers[slow] = struct{}{}
+	poller.watchers[fast] = struct{}{}
+	watchers := []*mempoolWatcher{slow, fast}
+	records := map[*mempoolWatcher][]*submit.TxInMempool{
+		slow: {{}},
+		fast: {{}},
+	}
+
+	// A watcher which falls too far behind is ended without holding up the
+	// others
+	for range watchMempoolBacklog + 1 {
+		poller.deliver(watchers, records, nil)
+		<-fast.recordChan
+	}
+	if _, ok := poller.watchers[slow]; ok {
+		t.Fatalf("did not get expected removal of slow watcher")
+	}
+	for range slow.recordChan {
+	}
+	if connect.CodeOf(slow.err) != connect.CodeResourceExhausted {
+		t.Fatalf("did not get expected error: %v", slow.err)
+	}
+
+	// A failed poll ends the remaining watchers
+	pollErr := errors.New("poll failed")
+	poller.deliver(watchers, nil, pollErr)
+	if _, ok := <-fast.recordChan; ok {
+		t.Fatalf("did not get expected closed record channel")
+	}
+	if !errors.Is(fast.err, pollErr) {
+		t.Fatalf("did not get expected error: %v", fast.err)
+	}
+	if len(poller.watchers) != 0 {
+		t.Fatalf("unexpected watchers: %v", poller.watchers)
+	}
+}
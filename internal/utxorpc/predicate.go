@@ -0,0 +1,627 @@
+// Copyright 2025 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utxorpc
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"slices"
+
+	lcommon "github.com/blinklabs-io/gouroboros/ledger/common"
+	"github.com/utxorpc/go-codegen/utxorpc/v1alpha/cardano"
+	"github.com/utxorpc/go-codegen/utxorpc/v1alpha/query"
+	"github.com/utxorpc/go-codegen/utxorpc/v1alpha/submit"
+	"github.com/utxorpc/go-codegen/utxorpc/v1alpha/watch"
+)
+
+// txPredicate is a chain-specific form of the TxPredicate messages from the
+// UTxO RPC watch and submit services, which share the same structure. A nil
+// predicate matches every transaction.
+type txPredicate struct {
+	match *cardano.TxPattern
+	not   []*txPredicate
+	allOf []*txPredicate
+	anyOf []*txPredicate
+}
+
+func newTxPredicateFromWatch(predicate *watch.TxPredicate) *txPredicate {
+	if predicate == nil {
+		return nil
+	}
+	ret := &txPredicate{
+		match: predicate.GetMatch().GetCardano(),
+	}
+	for _, tmpPredicate := range predicate.GetNot() {
+		ret.not = append(ret.not, newTxPredicateFromWatch(tmpPredicate))
+	}
+	for _, tmpPredicate := range predicate.GetAllOf() {
+		ret.allOf = append(ret.allOf, newTxPredicateFromWatch(tmpPredicate))
+	}
+	for _, tmpPredicate := range predicate.GetAnyOf() {
+		ret.anyOf = append(ret.anyOf, newTxPredicateFromWatch(tmpPredicate))
+	}
+	return ret
+}
+
+func newTxPredicateFromSubmit(predicate *submit.TxPredicate) *txPredicate {
+	if predicate == nil {
+		return nil
+	}
+	ret := &txPredicate{
+		match: predicate.GetMatch().GetCardano(),
+	}
+	for _, tmpPredicate := range predicate.GetNot() {
+		ret.not = append(ret.not, newTxPredicateFromSubmit(tmpPredicate))
+	}
+	for _, tmpPredicate := range predicate.GetAllOf() {
+		ret.allOf = append(ret.allOf, newTxPredicateFromSubmit(tmpPredicate))
+	}
+	for _, tmpPredicate := range predicate.GetAnyOf() {
+		ret.anyOf = append(ret.anyOf, newTxPredicateFromSubmit(tmpPredicate))
+	}
+	return ret
+}
+
+// resolvesInputs returns whether the predicate has patterns on the outputs
+// consumed by a transaction, which can only match resolved inputs
+func (p *txPredicate) resolvesInputs() bool {
+	if p == nil {
+		return false
+	}
+	if p.match.GetConsumes() != nil || p.match.GetHasAddress() != nil ||
+		p.match.GetMovesAsset() != nil {
+		return true
+	}
+	return slices.ContainsFunc(
+		slices.Concat(p.not, p.allOf, p.anyOf),
+		(*txPredicate).resolvesInputs,
+	)
+}
+
+// txInputResolver returns the output spent by a transaction input, or nil if
+// it's not known
+type txInputResolver func(lcommon.TransactionInput) lcommon.TransactionOutput
+
+// matches returns whether the transaction exhibits the pattern, if any, along
+// with none of the not predicates, all of the all_of predicates and at least
+// one of the any_of predicates. Patterns on consumed outputs can only match
+// inputs which the resolver knows about.
+func (p *txPredicate) matches(
+	tx lcommon.Transaction,
+	resolve txInputResolver,
+) bool {
+	if p == nil {
+		return true
+	}
+	if p.match != nil && !matchTxPattern(p.match, tx, resolve) {
+		return false
+	}
+	for _, tmpPredicate := range p.not {
+		if tmpPredicate.matches(tx, resolve) {
+			return false
+		}
+	}
+	for _, tmpPredicate := range p.allOf {
+		if !tmpPredicate.matches(tx, resolve) {
+			return false
+		}
+	}
+	if len(p.anyOf) == 0 {
+		return true
+	}
+	return slices.ContainsFunc(
+		p.anyOf,
+		func(tmpPredicate *txPredicate) bool {
+			return tmpPredicate.matches(tx, resolve)
+		},
+	)
+}
+
+// matchTxPattern returns whether the transaction matches all of the criteria
+// set in the pattern
+func matchTxPattern(
+	pattern *cardano.TxPattern,
+	tx lcommon.Transaction,
+	resolve txInputResolver,
+) bool {
+	var consumed []lcommon.TransactionOutput
+	for _, input := range tx.Inputs() {
+		if resolve == nil {
+			break
+		}
+		if output := resolve(input); output != nil {
+			consumed = append(consumed, output)
+		}
+	}
+	produced := tx.Outputs()
+	if pattern.GetConsumes() != nil &&
+		!anyOutputMatches(consumed, pattern.GetConsumes()) {
+		return false
+	}
+	if pattern.GetProduces() != nil &&
+		!anyOutputMatches(produced, pattern.GetProduces()) {
+		return false
+	}
+	if pattern.GetHasAddress() != nil {
+		// Collateral and its return are included, as they move funds when a
+		// script fails
+		outputs := slices.Concat(consumed, produced)
+		for _, input := range tx.Collateral() {
+			if resolve == nil {
+				break
+			}
+			if output := resolve(input); output != nil {
+				outputs = append(outputs, output)
+			}
+		}
+		if tx.CollateralReturn() != nil {
+			outputs = append(outputs, tx.CollateralReturn())
+		}
+		addressPattern := &cardano.TxOutputPattern{
+			Address: pattern.GetHasAddress(),
+		}
+		if !anyOutputMatches(outputs, addressPattern) {
+			return false
+		}
+	}
+	if pattern.GetMovesAsset() != nil {
+		assetPattern := &cardano.TxOutputPattern{
+			Asset: pattern.GetMovesAsset(),
+		}
+		if !anyOutputMatches(slices.Concat(consumed, produced), assetPattern) {
+			return false
+		}
+	}
+	if pattern.GetMintsAsset() != nil &&
+		!matchAssetPattern(pattern.GetMintsAsset(), tx.AssetMint()) {
+		return false
+	}
+	if certPattern := pattern.GetHasCertificate(); certPattern != nil &&
+		!slices.ContainsFunc(
+			tx.Certificates(),
+			func(cert lcommon.Certificate) bool {
+				return matchCertificatePattern(certPattern, cert)
+			},
+		) {
+		return false
+	}
+	return true
+}
+
+func anyOutputMatches(
+	outputs []lcommon.TransactionOutput,
+	pattern *cardano.TxOutputPattern,
+) bool {
+	return slices.ContainsFunc(
+		outputs,
+		func(output lcommon.TransactionOutput) bool {
+			return matchTxOutputPattern(pattern, output)
+		},
+	)
+}
+
+func matchTxOutputPattern(
+	pattern *cardano.TxOutputPattern,
+	output lcommon.TransactionOutput,
+) bool {
+	return matchAddressPattern(pattern.GetAddress(), output.Address()) &&
+		matchAssetPattern(pattern.GetAsset(), output.Assets())
+}
+
+// matchAddressPattern returns whether the address matches the exact address,
+// payment part and delegation part set in the pattern
+func matchAddressPattern(
+	pattern *cardano.AddressPattern,
+	addr lcommon.Address,
+) bool {
+	if exact := pattern.GetExactAddress(); len(exact) > 0 {
+		addrBytes, err := addr.Bytes()
+		if err != nil || !bytes.Equal(addrBytes, exact) {
+			return false
+		}
+	}
+	if paymentPart := pattern.GetPaymentPart(); len(paymentPart) > 0 {
+		if addr.PayloadPayload() == nil {
+			return false
+		}
+		paymentKeyHash := addr.PaymentKeyHash()
+		if !bytes.Equal(paymentKeyHash.Bytes(), paymentPart) {
+			return false
+		}
+	}
+	if delegationPart := pattern.GetDelegationPart(); len(delegationPart) > 0 {
+		cred, ok := addr.StakeCredential()
+		if !ok || !bytes.Equal(cred.Credential.Bytes(), delegationPart) {
+			return false
+		}
+	}
+	return true
+}
+
+// matchAssetPattern returns whether any of the assets has the policy ID and
+// asset name set in the pattern. A pattern without either matches anything.
+func matchAssetPattern[T int64 | uint64 | *big.Int](
+	pattern *cardano.AssetPattern,
+	assets *lcommon.MultiAsset[T],
+) bool {
+	policyId := pattern.GetPolicyId()
+	assetName := pattern.GetAssetName()
+	if len(policyId) == 0 && len(assetName) == 0 {
+		return true
+	}
+	if assets == nil {
+		return false
+	}
+	for _, policy := range assets.Policies() {
+		if len(policyId) > 0 && !bytes.Equal(policy.Bytes(), policyId) {
+			continue
+		}
+		if len(assetName) == 0 {
+			return true
+		}
+		for _, name := range assets.Assets(policy) {
+			if bytes.Equal(name, assetName) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// certificateRefs describes what a certificate does and the parties it
+// refers to
+type certificateRefs struct {
+	stakeCredential  *lcommon.Credential
+	poolKeyHash      *lcommon.PoolKeyHash
+	drep             []byte
+	registersStake   bool
+	deregistersStake bool
+	delegatesStake   bool
+}
+
+func newCertificateRefs(cert lcommon.Certificate) certificateRefs {
+	switch c := cert.(type) {
+	case *lcommon.StakeRegistrationCertificate:
+		return certificateRefs{
+			stakeCredential: &c.StakeCredential,
+			registersStake:  true,
+		}
+	case *lcommon.RegistrationCertificate:
+		return certificateRefs{
+			stakeCredential: &c.StakeCredential,
+			registersStake:  true,
+		}
+	case *lcommon.StakeDeregistrationCertificate:
+		return certificateRefs{
+			stakeCredential:  &c.StakeCredential,
+			deregistersStake: true,
+		}
+	case *lcommon.DeregistrationCertificate:
+		return certificateRefs{
+			stakeCredential:  &c.StakeCredential,
+			deregistersStake: true,
+		}
+	case *lcommon.StakeDelegationCertificate:
+		return certificateRefs{
+			stakeCredential: c.StakeCredential,
+			poolKeyHash:     &c.PoolKeyHash,
+			delegatesStake:  true,
+		}
+	case *lcommon.StakeRegistrationDelegationCertificate:
+		return certificateRefs{
+			stakeCredential: &c.StakeCredential,
+			poolKeyHash:     &c.PoolKeyHash,
+			registersStake:  true,
+			delegatesStake:  true,
+		}
+	case *lcommon.StakeVoteDelegationCertificate:
+		return certificateRefs{
+			stakeCredential: &c.StakeCredential,
+			poolKeyHash:     &c.PoolKeyHash,
+			drep:            c.Drep.Credential,
+			delegatesStake:  true,
+		}
+	case *lcommon.StakeVoteRegistrationDelegationCertificate:
+		return certificateRefs{
+			stakeCredential: &c.StakeCredential,
+			poolKeyHash:     &c.PoolKeyHash,
+			drep:            c.Drep.Credential,
+			registersStake:  true,
+			delegatesStake:  true,
+		}
+	case *lcommon.VoteDelegationCertificate:
+		return certificateRefs{
+			stakeCredential: &c.StakeCredential,
+			drep:            c.Drep.Credential,
+		}
+	case *lcommon.VoteRegistrationDelegationCertificate:
+		return certificateRefs{
+			stakeCredential: &c.StakeCredential,
+			drep:            c.Drep.Credential,
+			registersStake:  true,
+		}
+	case *lcommon.PoolRegistrationCertificate:
+		return certificateRefs{
+			poolKeyHash: &c.Operator,
+		}
+	case *lcommon.PoolRetirementCertificate:
+		return certificateRefs{
+			poolKeyHash: &c.PoolKeyHash,
+		}
+	case *lcommon.RegistrationDrepCertificate:
+		return certificateRefs{
+			drep: c.DrepCredential.Credential.Bytes(),
+		}
+	case *lcommon.DeregistrationDrepCertificate:
+		return certificateRefs{
+			drep: c.DrepCredential.Credential.Bytes(),
+		}
+	case *lcommon.UpdateDrepCertificate:
+		return certificateRefs{
+			drep: c.DrepCredential.Credential.Bytes(),
+		}
+	default:
+		return certificateRefs{}
+	}
+}
+
+func matchCertificatePattern(
+	pattern *cardano.CertificatePattern,
+	cert lcommon.Certificate,
+) bool {
+	refs := newCertificateRefs(cert)
+	switch p := pattern.GetCertificateType().(type) {
+	case *cardano.CertificatePattern_StakeRegistration:
+		return refs.registersStake &&
+			matchStakeCredential(p.StakeRegistration, refs.stakeCredential)
+	case *cardano.CertificatePattern_StakeDeregistration:
+		return refs.deregistersStake &&
+			matchStakeCredential(p.StakeDeregistration, refs.stakeCredential)
+	case *cardano.CertificatePattern_StakeDelegation:
+		return refs.delegatesStake &&
+			matchStakeCredential(
+				p.StakeDelegation.GetStakeCredential(),
+				refs.stakeCredential,
+			) &&
+			matchPoolKeyHash(
+				p.StakeDelegation.GetPoolKeyhash(),
+				refs.poolKeyHash,
+			)
+	case *cardano.CertificatePattern_PoolRegistration:
+		if _, ok := cert.(*lcommon.PoolRegistrationCertificate); !ok {
+			return false
+		}
+		// The pool ID is the hash of the operator key
+		return matchPoolKeyHash(
+			p.PoolRegistration.GetOperator(),
+			refs.poolKeyHash,
+		) &&
+			matchPoolKeyHash(
+				p.PoolRegistration.GetPoolKeyhash(),
+				refs.poolKeyHash,
+			)
+	case *cardano.CertificatePattern_PoolRetirement:
+		c, ok := cert.(*lcommon.PoolRetirementCertificate)
+		if !ok {
+			return false
+		}
+		epoch := p.PoolRetirement.GetEpoch()
+		return matchPoolKeyHash(
+			p.PoolRetirement.GetPoolKeyhash(),
+			refs.poolKeyHash,
+		) &&
+			(epoch == 0 || c.Epoch == epoch)
+	case *cardano.CertificatePattern_AnyStakeCredential:
+		return refs.stakeCredential != nil &&
+			bytes.Equal(
+				refs.stakeCredential.Credential.Bytes(),
+				p.AnyStakeCredential,
+			)
+	case *cardano.CertificatePattern_AnyPoolKeyhash:
+		return refs.poolKeyHash != nil &&
+			bytes.Equal(refs.poolKeyHash.Bytes(), p.AnyPoolKeyhash)
+	case *cardano.CertificatePattern_AnyDrep:
+		return refs.drep != nil && bytes.Equal(refs.drep, p.AnyDrep)
+	default:
+		// No certificate type was set
+		return true
+	}
+}
+
+// matchStakeCredential returns whether the credential matches the pattern,
+// which may be nil to match any credential
+func matchStakeCredential(
+	pattern *cardano.StakeCredential,
+	cred *lcommon.Credential,
+) bool {
+	if pattern.GetStakeCredential() == nil {
+		return true
+	}
+	if cred == nil {
+		return false
+	}
+	switch p := pattern.GetStakeCredential().(type) {
+	case *cardano.StakeCredential_AddrKeyHash:
+		return cred.CredType == lcommon.CredentialTypeAddrKeyHash &&
+			bytes.Equal(cred.Credential.Bytes(), p.AddrKeyHash)
+	case *cardano.StakeCredential_ScriptHash:
+		return cred.CredType == lcommon.CredentialTypeScriptHash &&
+			bytes.Equal(cred.Credential.Bytes(), p.ScriptHash)
+	default:
+		return false
+	}
+}
+
+// matchPoolKeyHash returns whether the pool key hash matches the pattern,
+// which may be empty to match any pool
+func matchPoolKeyHash(pattern []byte, poolKeyHash *lcommon.PoolKeyHash) bool {
+	if len(pattern) == 0 {
+		return true
+	}
+	return poolKeyHash != nil && bytes.Equal(poolKeyHash.Bytes(), pattern)
+}
+
+// utxoPredicate is a chain-specific form of the UtxoPredicate message from the
+// UTxO RPC query service. A nil predicate matches every UTxO.
+type utxoPredicate struct {
+	match *cardano.TxOutputPattern
+	not   []*utxoPredicate
+	allOf []*utxoPredicate
+	anyOf []*utxoPredicate
+}
+
+func newUtxoPredicate(predicate *query.UtxoPredicate) *utxoPredicate {
+	if predicate == nil {
+		return nil
+	}
+	ret := &utxoPredicate{
+		match: predicate.GetMatch().GetCardano(),
+	}
+	for _, tmpPredicate := range predicate.GetNot() {
+		ret.not = append(ret.not, newUtxoPredicate(tmpPredicate))
+	}
+	for _, tmpPredicate := range predicate.GetAllOf() {
+		ret.allOf = append(ret.allOf, newUtxoPredicate(tmpPredicate))
+	}
+	for _, tmpPredicate := range predicate.GetAnyOf() {
+		ret.anyOf = append(ret.anyOf, newUtxoPredicate(tmpPredicate))
+	}
+	return ret
+}
+
+// matches returns whether the output exhibits the pattern, if any, along with
+// none of the not predicates, all of the all_of predicates and at least one
+// of the any_of predicates
+func (p *utxoPredicate) matches(output lcommon.TransactionOutput) bool {
+	if p == nil {
+		return true
+	}
+	if p.match != nil && !matchTxOutputPattern(p.match, output) {
+		return false
+	}
+	for _, tmpPredicate := range p.not {
+		if tmpPredicate.matches(output) {
+			return false
+		}
+	}
+	for _, tmpPredicate := range p.allOf {
+		if !tmpPredicate.matches(output) {
+			return false
+		}
+	}
+	if len(p.anyOf) == 0 {
+		return true
+	}
+	return slices.ContainsFunc(
+		p.anyOf,
+		func(tmpPredicate *utxoPredicate) bool {
+			return tmpPredicate.matches(output)
+		},
+	)
+}
+
+// exactAddresses returns a set of addresses which includes the address of
+// every UTxO matching the predicate, so that they can be looked up by address
+// instead of scanning the whole UTxO set. It returns false if the predicate
+// can match UTxOs at other addresses.
+func (p *utxoPredicate) exactAddresses() ([][]byte, bool) {
+	if p == nil {
+		return nil, false
+	}
+	// Any of the conditions which must all hold is enough to narrow down the
+	// addresses
+	if exact := p.match.GetAddress().GetExactAddress(); len(exact) > 0 {
+		return [][]byte{exact}, true
+	}
+	for _, tmpPredicate := range p.allOf {
+		if ret, ok := tmpPredicate.exactAddresses(); ok {
+			return ret, true
+		}
+	}
+	if len(p.anyOf) == 0 {
+		return nil, false
+	}
+	var ret [][]byte
+	for _, tmpPredicate := range p.anyOf {
+		addrs, ok := tmpPredicate.exactAddresses()
+		if !ok {
+			return nil, false
+		}
+		ret = append(ret, addrs...)
+	}
+	return ret, true
+}
+
+// outputCache keeps the outputs produced by recently seen transactions, so
+// that the inputs of later transactions can be resolved without querying the
+// ledger, which no longer has them once they've been spent
+type outputCache struct {
+	size    int
+	outputs map[string]lcommon.TransactionOutput
+	order   []string
+}
+
+func newOutputCache(size int) *outputCache {
+	return &outputCache{
+		size:    size,
+		outputs: make(map[string]lcommon.TransactionOutput),
+	}
+}
+
+func outputCacheKey(input lcommon.TransactionInput) string {
+	return fmt.Sprintf("%s#%d", input.Id().String(), input.Index())
+}
+
+// update removes the outputs consumed by the transaction and adds the ones it
+// produced
+func (c *outputCache) update(tx lcommon.Transaction) {
+	for _, input := range tx.Consumed() {
+		delete(c.outputs, outputCacheKey(input))
+	}
+	c.add(tx)
+}
+
+// add adds the outputs produced by the transaction
+func (c *outputCache) add(tx lcommon.Transaction) {
+	for _, utxo := range tx.Produced() {
+		key := outputCacheKey(utxo.Id)
+		c.outputs[key] = utxo.Output
+		c.order = append(c.order, key)
+	}
+	// Drop the oldest outputs, skipping over any which were already spent
+	for len(c.outputs) > c.size && len(c.order) > 0 {
+		delete(c.outputs, c.order[0])
+		c.order = c.order[1:]
+	}
+	if len(c.order) > 2*c.size {
+		c.order = slices.DeleteFunc(c.order, func(key string) bool {
+			_, ok := c.outputs[key]
+			return !ok
+		})
+	}
+}
+
+// clear removes all outputs
+func (c *outputCache) clear() {
+	clear(c.outputs)
+	c.order = nil
+}
+
+func (c *outputCache) resolve(
+	input lcommon.TransactionInput,
+) lcommon.TransactionOutput {
+	return c.outputs[outputCacheKey(input)]
+}
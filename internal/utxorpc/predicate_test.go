@@ -0,0 +1,545 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utxorpc
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/blinklabs-io/gouroboros/cbor"
+	"github.com/blinklabs-io/gouroboros/ledger/babbage"
+	lcommon "github.com/blinklabs-io/gouroboros/ledger/common"
+	"github.com/blinklabs-io/gouroboros/ledger/conway"
+	"github.com/blinklabs-io/gouroboros/ledger/mary"
+	"github.com/blinklabs-io/gouroboros/ledger/shelley"
+	"github.com/utxorpc/go-codegen/utxorpc/v1alpha/cardano"
+	"github.com/utxorpc/go-codegen/utxorpc/v1alpha/query"
+	"github.com/utxorpc/go-codegen/utxorpc/v1alpha/watch"
+)
+
+var (
+	testPaymentHash = bytes.Repeat([]byte{0x01}, 28)
+	testStakeHash   = bytes.Repeat([]byte{0x02}, 28)
+	testOtherHash   = bytes.Repeat([]byte{0x03}, 28)
+	testPolicyId    = bytes.Repeat([]byte{0x04}, 28)
+	testPoolHash    = bytes.Repeat([]byte{0x05}, 28)
+	testAssetName   = []byte("token")
+)
+
+func testAddress(t *testing.T, paymentHash []byte) lcommon.Address {
+	t.Helper()
+	addr, err := lcommon.NewAddressFromParts(
+		lcommon.AddressTypeKeyKey,
+		lcommon.AddressNetworkMainnet,
+		paymentHash,
+		testStakeHash,
+	)
+	if err != nil {
+		t.Fatalf("unexpected error creating address: %v", err)
+	}
+	return addr
+}
+
+// testTx returns a transaction paying to the provided address, with the
+// provided assets minted and moved to that output
+func testTx(
+	t *testing.T,
+	body []byte,
+	input shelley.ShelleyTransactionInput,
+	paymentHash []byte,
+	withAssets bool,
+) *conway.ConwayTransaction {
+	t.Helper()
+	output := babbage.BabbageTransactionOutput{
+		OutputAddress: testAddress(t, paymentHash),
+		OutputAmount:  mary.MaryTransactionOutputValue{Amount: 2000000},
+	}
+	tx := &conway.ConwayTransaction{
+		Body: conway.ConwayTransactionBody{
+			TxInputs: conway.NewConwayTransactionInputSet(
+				[]shelley.ShelleyTransactionInput{input},
+			),
+			TxOutputs: []babbage.BabbageTransactionOutput{output},
+		},
+		TxIsValid: true,
+	}
+	if withAssets {
+		data := map[lcommon.Blake2b224]map[cbor.ByteString]*big.Int{
+			lcommon.NewBlake2b224(testPolicyId): {
+				cbor.NewByteString(testAssetName): big.NewInt(1),
+			},
+		}
+		outputAssets := lcommon.NewMultiAsset(data)
+		mintAssets := lcommon.NewMultiAsset(data)
+		tx.Body.TxOutputs[0].OutputAmount.Assets = &outputAssets
+		tx.Body.TxMint = &mintAssets
+	}
+	// The transaction ID is the hash of the body CBOR
+	tx.Body.SetCbor(body)
+	return tx
+}
+
+func TestTxPredicateMatches(t *testing.T) {
+	input := shelley.NewShelleyTransactionInput(
+		"0000000000000000000000000000000000000000000000000000000000000000",
+		0,
+	)
+	tx := testTx(t, []byte{0x01}, input, testPaymentHash, true)
+	pattern := func(p *cardano.TxPattern) *watch.TxPredicate {
+		return &watch.TxPredicate{
+			Match: &watch.AnyChainTxPattern{
+				Chain: &watch.AnyChainTxPattern_Cardano{Cardano: p},
+			},
+		}
+	}
+	paysTo := func(paymentHash []byte) *watch.TxPredicate {
+		return pattern(&cardano.TxPattern{
+			Produces: &cardano.TxOutputPattern{
+				Address: &cardano.AddressPattern{PaymentPart: paymentHash},
+			},
+		})
+	}
+	testDefs := []struct {
+		name      string
+		predicate *watch.TxPredicate
+		expected  bool
+	}{
+		{
+			name:     "nil predicate",
+			expected: true,
+		},
+		{
+			name:      "produces payment part",
+			predicate: paysTo(testPaymentHash),
+			expected:  true,
+		},
+		{
+			name:      "produces other payment part",
+			predicate: paysTo(testOtherHash),
+			expected:  false,
+		},
+		{
+			name: "has delegation part",
+			predicate: pattern(&cardano.TxPattern{
+				HasAddress: &cardano.AddressPattern{
+					DelegationPart: testStakeHash,
+				},
+			}),
+			expected: true,
+		},
+		{
+			name: "mints asset",
+			predicate: pattern(&cardano.TxPattern{
+				MintsAsset: &cardano.AssetPattern{
+					PolicyId:  testPolicyId,
+					AssetName: testAssetName,
+				},
+			}),
+			expected: true,
+		},
+		{
+			name: "moves other asset",
+			predicate: pattern(&cardano.TxPattern{
+				MovesAsset: &cardano.AssetPattern{PolicyId: testOtherHash},
+			}),
+			expected: false,
+		},
+		{
+			name: "consumes unresolved input",
+			predicate: pattern(&cardano.TxPattern{
+				Consumes: &cardano.TxOutputPattern{
+					Address: &cardano.AddressPattern{
+						PaymentPart: testPaymentHash,
+					},
+				},
+			}),
+			expected: false,
+		},
+		{
+			name: "not",
+			predicate: &watch.TxPredicate{
+				Not: []*watch.TxPredicate{paysTo(testPaymentHash)},
+			},
+			expected: false,
+		},
+		{
+			name: "all of",
+			predicate: &watch.TxPredicate{
+				AllOf: []*watch.TxPredicate{
+					paysTo(testPaymentHash),
+					paysTo(testOtherHash),
+				},
+			},
+			expected: false,
+		},
+		{
+			name: "any of",
+			predicate: &watch.TxPredicate{
+				AnyOf: []*watch.TxPredicate{
+					paysTo(testOtherHash),
+					paysTo(testPaymentHash),
+				},
+			},
+			expected: true,
+		},
+	}
+	for _, testDef := range testDefs {
+		got := newTxPredicateFromWatch(testDef.predicate).matches(tx, nil)
+		if got != testDef.expected {
+			t.Fatalf(
+				"unexpected result for %s: got %v, expected %v",
+				testDef.name,
+				got,
+				testDef.expected,
+			)
+		}
+	}
+}
+
+func TestTxPredicateConsumesCachedOutput(t *testing.T) {
+	firstTx := testTx(
+		t,
+		[]byte{0x01},
+		shelley.NewShelleyTransactionInput(
+			"0000000000000000000000000000000000000000000000000000000000000000",
+			0,
+		),
+		testPaymentHash,
+		false,
+	)
+	secondTx := testTx(
+		t,
+		[]byte{0x02},
+		shelley.NewShelleyTransactionInput(firstTx.Hash().String(), 0),
+		testOtherHash,
+		false,
+	)
+	predicate := &txPredicate{
+		match: &cardano.TxPattern{
+			Consumes: &cardano.TxOutputPattern{
+				Address: &cardano.AddressPattern{PaymentPart: testPaymentHash},
+			},
+		},
+	}
+	outputs := newOutputCache(10)
+	outputs.update(firstTx)
+	if !predicate.matches(secondTx, outputs.resolve) {
+		t.Fatalf("unexpected result: consumed output was not resolved")
+	}
+	// The output is forgotten once spent
+	outputs.update(secondTx)
+	if predicate.matches(secondTx, outputs.resolve) {
+		t.Fatalf("unexpected result: spent output was resolved")
+	}
+	// Outputs are forgotten when a rollback clears the cache
+	outputs.update(firstTx)
+	outputs.clear()
+	if predicate.matches(secondTx, outputs.resolve) {
+		t.Fatalf("unexpected result: cleared output was resolved")
+	}
+}
+
+func TestTxPredicateResolvesInputs(t *testing.T) {
+	addressPattern := &cardano.AddressPattern{PaymentPart: testPaymentHash}
+	testDefs := []struct {
+		name      string
+		predicate *txPredicate
+		expected  bool
+	}{
+		{
+			name: "Nil",
+		},
+		{
+			name: "Produces",
+			predicate: &txPredicate{
+				match: &cardano.TxPattern{
+					Produces: &cardano.TxOutputPattern{Address: addressPattern},
+				},
+			},
+		},
+		{
+			name: "Consumes",
+			predicate: &txPredicate{
+				match: &cardano.TxPattern{
+					Consumes: &cardano.TxOutputPattern{Address: addressPattern},
+				},
+			},
+			expected: true,
+		},
+		{
+			name: "NestedHasAddress",
+			predicate: &txPredicate{
+				anyOf: []*txPredicate{
+					{
+						match: &cardano.TxPattern{
+							MintsAsset: &cardano.AssetPattern{
+								PolicyId: testPolicyId,
+							},
+						},
+					},
+					{
+						match: &cardano.TxPattern{HasAddress: addressPattern},
+					},
+				},
+			},
+			expected: true,
+		},
+	}
+	for _, testDef := range testDefs {
+		t.Run(testDef.name, func(t *testing.T) {
+			got := testDef.predicate.resolvesInputs()
+			if got != testDef.expected {
+				t.Fatalf(
+					"unexpected result: got %v, wanted %v",
+					got,
+					testDef.expected,
+				)
+			}
+		})
+	}
+}
+
+func TestMatchCertificatePattern(t *testing.T) {
+	stakeCred := lcommon.Credential{
+		CredType:   lcommon.CredentialTypeAddrKeyHash,
+		Credential: lcommon.NewBlake2b224(testStakeHash),
+	}
+	delegation := &lcommon.StakeDelegationCertificate{
+		StakeCredential: &stakeCred,
+		PoolKeyHash:     lcommon.NewBlake2b224(testPoolHash),
+	}
+	registration := &lcommon.StakeRegistrationCertificate{
+		StakeCredential: stakeCred,
+	}
+	keyHashCred := &cardano.StakeCredential{
+		StakeCredential: &cardano.StakeCredential_AddrKeyHash{
+			AddrKeyHash: testStakeHash,
+		},
+	}
+	scriptHashCred := &cardano.StakeCredential{
+		StakeCredential: &cardano.StakeCredential_ScriptHash{
+			ScriptHash: testStakeHash,
+		},
+	}
+	deregistrationPattern := &cardano.CertificatePattern_StakeDeregistration{
+		StakeDeregistration: keyHashCred,
+	}
+	testDefs := []struct {
+		name     string
+		pattern  *cardano.CertificatePattern
+		cert     lcommon.Certificate
+		expected bool
+	}{
+		{
+			name: "stake delegation to pool",
+			pattern: &cardano.CertificatePattern{
+				CertificateType: &cardano.CertificatePattern_StakeDelegation{
+					StakeDelegation: &cardano.StakeDelegationPattern{
+						StakeCredential: keyHashCred,
+						PoolKeyhash:     testPoolHash,
+					},
+				},
+			},
+			cert:     delegation,
+			expected: true,
+		},
+		{
+			name: "stake delegation by script",
+			pattern: &cardano.CertificatePattern{
+				CertificateType: &cardano.CertificatePattern_StakeDelegation{
+					StakeDelegation: &cardano.StakeDelegationPattern{
+						StakeCredential: scriptHashCred,
+					},
+				},
+			},
+			cert:     delegation,
+			expected: false,
+		},
+		{
+			name: "stake registration",
+			pattern: &cardano.CertificatePattern{
+				CertificateType: &cardano.CertificatePattern_StakeRegistration{
+					StakeRegistration: &cardano.StakeCredential{},
+				},
+			},
+			cert:     registration,
+			expected: true,
+		},
+		{
+			name: "stake deregistration",
+			pattern: &cardano.CertificatePattern{
+				CertificateType: deregistrationPattern,
+			},
+			cert:     registration,
+			expected: false,
+		},
+		{
+			name: "any pool key hash",
+			pattern: &cardano.CertificatePattern{
+				CertificateType: &cardano.CertificatePattern_AnyPoolKeyhash{
+					AnyPoolKeyhash: testPoolHash,
+				},
+			},
+			cert:     delegation,
+			expected: true,
+		},
+		{
+			name: "any stake credential",
+			pattern: &cardano.CertificatePattern{
+				CertificateType: &cardano.CertificatePattern_AnyStakeCredential{
+					AnyStakeCredential: testOtherHash,
+				},
+			},
+			cert:     registration,
+			expected: false,
+		},
+	}
+	for _, testDef := range testDefs {
+		got := matchCertificatePattern(testDef.pattern, testDef.cert)
+		if got != testDef.expected {
+			t.Fatalf(
+				"unexpected result for %s: got %v, expected %v",
+				testDef.name,
+				got,
+				testDef.expected,
+			)
+		}
+	}
+}
+
+func TestUtxoPredicate(t *testing.T) {
+	output := babbage.BabbageTransactionOutput{
+		OutputAddress: testAddress(t, testPaymentHash),
+		OutputAmount:  mary.MaryTransactionOutputValue{Amount: 2000000},
+	}
+	addrBytes, err := output.OutputAddress.Bytes()
+	if err != nil {
+		t.Fatalf("unexpected error encoding address: %v", err)
+	}
+	exact := func(addr []byte) *query.UtxoPredicate {
+		return &query.UtxoPredicate{
+			Match: &query.AnyUtxoPattern{
+				UtxoPattern: &query.AnyUtxoPattern_Cardano{
+					Cardano: &cardano.TxOutputPattern{
+						Address: &cardano.AddressPattern{ExactAddress: addr},
+					},
+				},
+			},
+		}
+	}
+	delegatesTo := func(stakeHash []byte) *query.UtxoPredicate {
+		return &query.UtxoPredicate{
+			Match: &query.AnyUtxoPattern{
+				UtxoPattern: &query.AnyUtxoPattern_Cardano{
+					Cardano: &cardano.TxOutputPattern{
+						Address: &cardano.AddressPattern{
+							DelegationPart: stakeHash,
+						},
+					},
+				},
+			},
+		}
+	}
+	testDefs := []struct {
+		name              string
+		predicate         *query.UtxoPredicate
+		expectedMatch     bool
+		expectedAddresses int
+	}{
+		{
+			name:              "nil predicate",
+			expectedMatch:     true,
+			expectedAddresses: -1,
+		},
+		{
+			name:              "exact address",
+			predicate:         exact(addrBytes),
+			expectedMatch:     true,
+			expectedAddresses: 1,
+		},
+		{
+			name:              "delegation part",
+			predicate:         delegatesTo(testStakeHash),
+			expectedMatch:     true,
+			expectedAddresses: -1,
+		},
+		{
+			name: "all of exact address and other delegation part",
+			predicate: &query.UtxoPredicate{
+				AllOf: []*query.UtxoPredicate{
+					delegatesTo(testOtherHash),
+					exact(addrBytes),
+				},
+			},
+			expectedMatch:     false,
+			expectedAddresses: 1,
+		},
+		{
+			name: "any of exact addresses",
+			predicate: &query.UtxoPredicate{
+				AnyOf: []*query.UtxoPredicate{
+					exact([]byte{0x01}),
+					exact(addrBytes),
+				},
+			},
+			expectedMatch:     true,
+			expectedAddresses: 2,
+		},
+		{
+			name: "any of exact address and delegation part",
+			predicate: &query.UtxoPredicate{
+				AnyOf: []*query.UtxoPredicate{
+					exact([]byte{0x01}),
+					delegatesTo(testStakeHash),
+				},
+			},
+			expectedMatch:     true,
+			expectedAddresses: -1,
+		},
+		{
+			name: "not exact address",
+			predicate: &query.UtxoPredicate{
+				Not: []*query.UtxoPredicate{exact(addrBytes)},
+			},
+			expectedMatch:     false,
+			expectedAddresses: -1,
+		},
+	}
+	for _, testDef := range testDefs {
+		predicate := newUtxoPredicate(testDef.predicate)
+		if got := predicate.matches(output); got != testDef.expectedMatch {
+			t.Fatalf(
+				"unexpected match result for %s: got %v, expected %v",
+				testDef.name,
+				got,
+				testDef.expectedMatch,
+			)
+		}
+		addrs, ok := predicate.exactAddresses()
+		if !ok {
+			addrs = nil
+		}
+		if (testDef.expectedAddresses < 0) == ok ||
+			(ok && len(addrs) != testDef.expectedAddresses) {
+			t.Fatalf(
+				"unexpected addresses for %s: %x, %v",
+				testDef.name,
+				addrs,
+				ok,
+			)
+		}
+	}
+}
@@ -17,18 +17,26 @@ package utxorpc
 import (
 	"bytes"
 	"context"
-	"encoding/hex"
 	"errors"
 	"fmt"
 	"log"
+	"slices"
 
 	connect "connectrpc.com/connect"
+	"github.com/blinklabs-io/cardano-node-api/internal/config"
 	"github.com/blinklabs-io/cardano-node-api/internal/node"
+	"github.com/blinklabs-io/gouroboros/cbor"
 	"github.com/blinklabs-io/gouroboros/ledger"
 	"github.com/blinklabs-io/gouroboros/ledger/common"
 	"github.com/blinklabs-io/gouroboros/protocol/localstatequery"
 	query "github.com/utxorpc/go-codegen/utxorpc/v1alpha/query"
 	"github.com/utxorpc/go-codegen/utxorpc/v1alpha/query/queryconnect"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+const (
+	searchUtxosDefaultItems = 100
+	searchUtxosMaxItems     = 1000
 )
 
 // queryServiceServer implements the WatchService API
@@ -45,26 +53,21 @@ func (s *queryServiceServer) ReadParams(
 	log.Printf("Got a ReadParams request with fieldMask %v", fieldMask)
 	resp := &query.ReadParamsResponse{}
 
-	// Connect to node
-	oConn, err := node.GetConnection(nil)
+	// Lease a node connection from the pool
+	lease, err := node.AcquireConnection(ctx)
 	if err != nil {
 		return nil, err
 	}
-	defer func() {
-		// Close Ouroboros connection
-		oConn.Close()
-	}()
-	// Start client
-	oConn.LocalStateQuery().Client.Start()
+	defer lease.Release()
 
 	// Get protoParams
-	protoParams, err := oConn.LocalStateQuery().Client.GetCurrentProtocolParams()
+	protoParams, err := lease.LocalStateQuery().GetCurrentProtocolParams()
 	if err != nil {
 		return nil, err
 	}
 
 	// Get chain point (slot and hash)
-	point, err := oConn.LocalStateQuery().Client.GetChainPoint()
+	point, err := lease.LocalStateQuery().GetChainPoint()
 	if err != nil {
 		return nil, err
 	}
@@ -98,17 +101,12 @@ func (s *queryServiceServer) ReadUtxos(
 	log.Printf("Got a ReadUtxos request with keys %v", keys)
 	resp := &query.ReadUtxosResponse{}
 
-	// Connect to node
-	oConn, err := node.GetConnection(nil)
+	// Lease a node connection from the pool
+	lease, err := node.AcquireConnection(ctx)
 	if err != nil {
 		return nil, err
 	}
-	defer func() {
-		// Close Ouroboros connection
-		oConn.Close()
-	}()
-	// Start client
-	oConn.LocalStateQuery().Client.Start()
+	defer lease.Release()
 
 	// Setup our query input
 	tmpTxIns := []ledger.TransactionInput{}
@@ -122,55 +120,54 @@ func (s *queryServiceServer) ReadUtxos(
 	}
 
 	// Get UTxOs
-	utxos, err := oConn.LocalStateQuery().Client.GetUTxOByTxIn(tmpTxIns)
+	utxos, err := node.UtxosByTxIn(lease.LocalStateQuery(), tmpTxIns)
 	if err != nil {
 		return nil, err
 	}
 
 	// Get chain point (slot and hash)
-	point, err := oConn.LocalStateQuery().Client.GetChainPoint()
+	point, err := lease.LocalStateQuery().GetChainPoint()
 	if err != nil {
 		return nil, err
 	}
 
-	for _, txo := range keys {
-		for utxoId, utxo := range utxos.Results {
-			var aud query.AnyUtxoData
-			var audc query.AnyUtxoData_Cardano
-			aud.TxoRef = txo
-			txHash := hex.EncodeToString(txo.GetHash())
-			if utxoId.Hash.String() == txHash &&
-				// #nosec G115
-				uint32(utxoId.Idx) == txo.GetIndex() {
-				aud.NativeBytes = utxo.Cbor()
-				utxoRpc, err := utxo.Utxorpc()
-				if err != nil {
-					return nil, err
-				}
-				audc.Cardano = utxoRpc
-				if audc.Cardano.GetDatum() != nil {
-					// Check if Datum.Hash is all zeroes
-					isAllZeroes := true
-					for _, b := range audc.Cardano.GetDatum().GetHash() {
-						if b != 0 {
-							isAllZeroes = false
-							break
-						}
-					}
-					if isAllZeroes {
-						// No actual datum; set Datum to nil to omit it
-						audc.Cardano.Datum = nil
-						log.Print(
-							"Datum Hash is all zeroes; setting Datum to nil",
-						)
-					} else {
-						log.Printf("Datum Hash present: %x", audc.Cardano.GetDatum().GetHash())
-					}
+	for _, utxo := range utxos {
+		var aud query.AnyUtxoData
+		var audc query.AnyUtxoData_Cardano
+		aud.TxoRef = &query.TxoRef{
+			Hash:  utxo.Id.Id().Bytes(),
+			Index: utxo.Id.Index(),
+		}
+		aud.NativeBytes = utxo.Output.Cbor()
+		utxoRpc, err := utxo.Output.Utxorpc()
+		if err != nil {
+			return nil, err
+		}
+		audc.Cardano = utxoRpc
+		if audc.Cardano.GetDatum() != nil {
+			// Check if Datum.Hash is all zeroes
+			isAllZeroes := true
+			for _, b := range audc.Cardano.GetDatum().GetHash() {
+				if b != 0 {
+					isAllZeroes = false
+					break
 				}
-				aud.ParsedState = &audc
 			}
-			resp.Items = append(resp.Items, &aud)
+			if isAllZeroes {
+				// No actual datum; set Datum to nil to omit it
+				audc.Cardano.Datum = nil
+				log.Print(
+					"Datum Hash is all zeroes; setting Datum to nil",
+				)
+			} else {
+				log.Printf(
+					"Datum Hash present: %x",
+					audc.Cardano.GetDatum().GetHash(),
+				)
+			}
 		}
+		aud.ParsedState = &audc
+		resp.Items = append(resp.Items, &aud)
 	}
 	resp.LedgerTip = &query.ChainPoint{
 		Slot: point.Slot,
@@ -185,162 +182,625 @@ func (s *queryServiceServer) ReadUtxos(
 	return connect.NewResponse(resp), nil
 }
 
-// SearchUtxos
+// SearchUtxos returns the UTxOs matching the predicate, ordered by
+// transaction input for paging. Predicates which don't require an exact
+// address scan the whole UTxO set, which is decoded in memory before it's
+// filtered. These scans are opt-in, and are only allowed when
+// MaxUtxoSearchResults is above 0.
 func (s *queryServiceServer) SearchUtxos(
 	ctx context.Context,
 	req *connect.Request[query.SearchUtxosRequest],
 ) (*connect.Response[query.SearchUtxosResponse], error) {
 	predicate := req.Msg.GetPredicate() // UtxoPredicate
-	log.Printf("Got a SearchUtxos request with predicate %v", predicate)
+	fieldMask := req.Msg.GetFieldMask()
+	maxItems := req.Msg.GetMaxItems()
+	startToken := req.Msg.GetStartToken()
+	log.Printf(
+		"Got a SearchUtxos request with predicate %v, fieldMask %v, maxItems %d and token %q",
+		predicate,
+		fieldMask,
+		maxItems,
+		startToken,
+	)
 	resp := &query.SearchUtxosResponse{}
 
-	if predicate == nil {
-		return nil, fmt.Errorf("ERROR: empty predicate: %v", predicate)
+	if len(fieldMask.GetPaths()) > 0 &&
+		!fieldMask.IsValid(&query.AnyUtxoData{}) {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			fmt.Errorf("invalid field mask %v", fieldMask.GetPaths()),
+		)
+	}
+	pageSize := int(maxItems)
+	if pageSize == 0 {
+		pageSize = searchUtxosDefaultItems
+	} else if pageSize < 0 || pageSize > searchUtxosMaxItems {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			fmt.Errorf(
+				"max_items must be between 0 and %d",
+				searchUtxosMaxItems,
+			),
+		)
+	}
+	// The start token is the reference of the first UTxO of the page
+	var startTxIn ledger.TransactionInput
+	if startToken != "" {
+		tmpTxIn, err := node.ParseTxIn(startToken)
+		if err != nil {
+			return nil, connect.NewError(
+				connect.CodeInvalidArgument,
+				fmt.Errorf("invalid start token: %w", err),
+			)
+		}
+		startTxIn = tmpTxIn
 	}
 
-	addressPattern := predicate.GetMatch().GetCardano().GetAddress()
-	assetPattern := predicate.GetMatch().GetCardano().GetAsset()
+	utxoPred := newUtxoPredicate(predicate)
+	search, err := newUtxoSearch(utxoPred)
+	if err != nil {
+		return nil, err
+	}
+	maxResults := config.GetConfig().Utxorpc.MaxUtxoSearchResults
 
-	// A Match can only contain EITHER addressPattern OR assetPattern, not both
-	if addressPattern != nil && assetPattern != nil {
-		return nil, errors.New(
-			"ERROR: Match cannot contain both address and asset patterns. Use AllOf predicate to combine them",
-		)
+	// Lease a node connection from the pool
+	lease, err := node.AcquireConnection(ctx)
+	if err != nil {
+		return nil, err
 	}
+	defer lease.Release()
 
-	// Connect to node
-	oConn, err := node.GetConnection(nil)
+	utxos, err := search.query(lease.LocalStateQuery())
 	if err != nil {
+		log.Printf("ERROR: %s", err)
 		return nil, err
 	}
-	defer func() {
-		oConn.Close()
-	}()
-	oConn.LocalStateQuery().Client.Start()
-
-	var utxos *localstatequery.UTxOsResult
-
-	// Handle address-only search
-	if addressPattern != nil {
-		var addresses []common.Address
-
-		// Handle Exact Address
-		exactAddressBytes := addressPattern.GetExactAddress()
-		if exactAddressBytes != nil {
-			var addr common.Address
-			err := addr.UnmarshalCBOR(exactAddressBytes)
-			if err != nil {
-				return nil, fmt.Errorf(
-					"failed to decode exact address: %w",
-					err,
-				)
-			}
-			addresses = append(addresses, addr)
-		}
 
-		// Handle Payment Part
-		paymentPart := addressPattern.GetPaymentPart()
-		if paymentPart != nil {
-			log.Printf("PaymentPart is present, decoding...")
-			var paymentAddr common.Address
-			err := paymentAddr.UnmarshalCBOR(paymentPart)
-			if err != nil {
-				return nil, fmt.Errorf("failed to decode payment part: %w", err)
-			}
-			addresses = append(addresses, paymentAddr)
-		}
+	// Get chain point (slot and hash)
+	point, err := lease.LocalStateQuery().GetChainPoint()
+	if err != nil {
+		log.Printf("ERROR: %s", err)
+		return nil, err
+	}
 
-		// Handle Delegation Part
-		delegationPart := addressPattern.GetDelegationPart()
-		if delegationPart != nil {
-			log.Printf("DelegationPart is present, decoding...")
-			var delegationAddr common.Address
-			err := delegationAddr.UnmarshalCBOR(delegationPart)
-			if err != nil {
-				return nil, fmt.Errorf(
-					"failed to decode delegation part: %w",
-					err,
-				)
-			}
-			addresses = append(addresses, delegationAddr)
+	// Filter the UTxOs and order them for paging
+	var matches []ledger.Utxo
+	for utxoId, output := range utxos.Results {
+		if !utxoPred.matches(output) {
+			continue
 		}
+		if search.wholeSet() && len(matches) >= maxResults {
+			return nil, connect.NewError(
+				connect.CodeResourceExhausted,
+				fmt.Errorf(
+					"predicate matches more than %d UTxOs, narrow it down with an exact address",
+					maxResults,
+				),
+			)
+		}
+		matches = append(
+			matches,
+			ledger.Utxo{
+				Id: ledger.ShelleyTransactionInput{
+					TxId:        utxoId.Hash,
+					OutputIndex: uint32(utxoId.Idx), // #nosec G115
+				},
+				Output: output,
+			},
+		)
+	}
+	node.SortUtxos(matches)
+	if startTxIn != nil {
+		idx, _ := slices.BinarySearchFunc(
+			matches,
+			startTxIn,
+			func(utxo ledger.Utxo, txIn ledger.TransactionInput) int {
+				return node.CompareTxIns(utxo.Id, txIn)
+			},
+		)
+		matches = matches[idx:]
+	}
+	if len(matches) > pageSize {
+		resp.NextToken = matches[pageSize].Id.String()
+		matches = matches[:pageSize]
+	}
 
-		// Get UTxOs by address
-		utxos, err = oConn.LocalStateQuery().Client.GetUTxOByAddress(addresses)
+	for _, utxo := range matches {
+		aud, err := newAnyUtxoData(utxo, fieldMask)
 		if err != nil {
-			log.Printf("ERROR: %s", err)
 			return nil, err
 		}
-	} else if assetPattern != nil {
-		// Handle asset-only search - get all UTxOs and filter by asset
-		utxos, err = oConn.LocalStateQuery().Client.GetUTxOWhole()
+		resp.Items = append(resp.Items, aud)
+	}
+
+	resp.LedgerTip = &query.ChainPoint{
+		Slot: point.Slot,
+		Hash: point.Hash,
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// utxoSearch is the node query used to find the UTxOs matching a predicate
+type utxoSearch struct {
+	// Addresses to look up, or nil to scan the whole UTxO set
+	addresses []common.Address
+}
+
+// newUtxoSearch returns a search by address when the predicate only matches
+// specific addresses, and a scan of the whole UTxO set otherwise
+func newUtxoSearch(predicate *utxoPredicate) (*utxoSearch, error) {
+	addrsBytes, ok := predicate.exactAddresses()
+	if !ok {
+		if config.GetConfig().Utxorpc.MaxUtxoSearchResults <= 0 {
+			return nil, connect.NewError(
+				connect.CodeInvalidArgument,
+				errors.New(
+					"predicate must match an exact address, as whole UTxO set searches are disabled",
+				),
+			)
+		}
+		return &utxoSearch{}, nil
+	}
+	ret := &utxoSearch{
+		addresses: make([]common.Address, 0, len(addrsBytes)),
+	}
+	for _, addrBytes := range addrsBytes {
+		addr, err := common.NewAddressFromBytes(addrBytes)
 		if err != nil {
-			log.Printf("ERROR: %s", err)
-			return nil, err
+			return nil, connect.NewError(
+				connect.CodeInvalidArgument,
+				fmt.Errorf("failed to decode exact address: %w", err),
+			)
 		}
-	} else {
-		return nil, errors.New("ERROR: Match must contain either address or asset pattern")
+		ret.addresses = append(ret.addresses, addr)
 	}
+	return ret, nil
+}
 
-	// Get chain point (slot and hash)
-	point, err := oConn.LocalStateQuery().Client.GetChainPoint()
+func (s *utxoSearch) wholeSet() bool {
+	return s.addresses == nil
+}
+
+func (s *utxoSearch) query(
+	client *localstatequery.Client,
+) (*localstatequery.UTxOsResult, error) {
+	if s.wholeSet() {
+		return client.GetUTxOWhole()
+	}
+	return client.GetUTxOByAddress(s.addresses)
+}
+
+// newAnyUtxoData returns the UTxO with the field mask applied
+func newAnyUtxoData(
+	utxo ledger.Utxo,
+	fieldMask *fieldmaskpb.FieldMask,
+) (*query.AnyUtxoData, error) {
+	utxoRpc, err := utxo.Output.Utxorpc()
+	if err != nil {
+		return nil, err
+	}
+	ret := &query.AnyUtxoData{
+		TxoRef: &query.TxoRef{
+			Hash:  utxo.Id.Id().Bytes(),
+			Index: utxo.Id.Index(),
+		},
+		NativeBytes: utxo.Output.Cbor(),
+		ParsedState: &query.AnyUtxoData_Cardano{
+			Cardano: utxoRpc,
+		},
+	}
+	if err := applyFieldMask(ret, fieldMask); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// StreamUtxos sends the UTxOs matching a SearchUtxos request as they're
+// filtered, in batches of up to max_items, so that large searches don't need
+// to be held in memory as a single response. Streaming the whole UTxO set
+// isn't supported: the node replies to the query with a single message,
+// which gouroboros receives and decodes in full before returning it, so
+// memory can't be bounded. The predicate must match exact addresses, which
+// bounds the reply to the UTxOs at those addresses. UTxOs are sent in no
+// particular order, so paging with start_token isn't supported. This method
+// isn't part of the generated QueryService, see streamUtxosProcedure.
+func (s *queryServiceServer) StreamUtxos(
+	ctx context.Context,
+	req *connect.Request[query.SearchUtxosRequest],
+	stream *connect.ServerStream[query.SearchUtxosResponse],
+) error {
+	predicate := req.Msg.GetPredicate() // UtxoPredicate
+	fieldMask := req.Msg.GetFieldMask()
+	maxItems := req.Msg.GetMaxItems()
+	log.Printf(
+		"Got a StreamUtxos request with predicate %v, fieldMask %v and maxItems %d",
+		predicate,
+		fieldMask,
+		maxItems,
+	)
+
+	if len(fieldMask.GetPaths()) > 0 &&
+		!fieldMask.IsValid(&query.AnyUtxoData{}) {
+		return connect.NewError(
+			connect.CodeInvalidArgument,
+			fmt.Errorf("invalid field mask %v", fieldMask.GetPaths()),
+		)
+	}
+	batchSize := int(maxItems)
+	if batchSize == 0 {
+		batchSize = searchUtxosDefaultItems
+	} else if batchSize < 0 || batchSize > searchUtxosMaxItems {
+		return connect.NewError(
+			connect.CodeInvalidArgument,
+			fmt.Errorf(
+				"max_items must be between 0 and %d",
+				searchUtxosMaxItems,
+			),
+		)
+	}
+	if req.Msg.GetStartToken() != "" {
+		return connect.NewError(
+			connect.CodeInvalidArgument,
+			errors.New("start_token is not supported by StreamUtxos"),
+		)
+	}
+	utxoPred := newUtxoPredicate(predicate)
+	if _, ok := utxoPred.exactAddresses(); !ok {
+		return connect.NewError(
+			connect.CodeUnimplemented,
+			errors.New(
+				"streaming the whole UTxO set is not supported, the predicate must match exact addresses",
+			),
+		)
+	}
+	search, err := newUtxoSearch(utxoPred)
+	if err != nil {
+		return err
+	}
+
+	// Lease a node connection from the pool. It's released as soon as the
+	// node has replied, so that slow clients don't hold on to it.
+	lease, err := node.AcquireConnection(ctx)
+	if err != nil {
+		return err
+	}
+	utxos, err := search.query(lease.LocalStateQuery())
+	if err != nil {
+		lease.Release()
+		log.Printf("ERROR: %s", err)
+		return err
+	}
+	point, err := lease.LocalStateQuery().GetChainPoint()
+	lease.Release()
 	if err != nil {
 		log.Printf("ERROR: %s", err)
+		return err
+	}
+	ledgerTip := &query.ChainPoint{
+		Slot: point.Slot,
+		Hash: point.Hash,
+	}
+
+	resp := &query.SearchUtxosResponse{
+		LedgerTip: ledgerTip,
+	}
+	for utxoId, output := range utxos.Results {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if !utxoPred.matches(output) {
+			continue
+		}
+		aud, err := newAnyUtxoData(
+			ledger.Utxo{
+				Id: ledger.ShelleyTransactionInput{
+					TxId:        utxoId.Hash,
+					OutputIndex: uint32(utxoId.Idx), // #nosec G115
+				},
+				Output: output,
+			},
+			fieldMask,
+		)
+		if err != nil {
+			return err
+		}
+		resp.Items = append(resp.Items, aud)
+		if len(resp.Items) < batchSize {
+			continue
+		}
+		if err := stream.Send(resp); err != nil {
+			return err
+		}
+		resp = &query.SearchUtxosResponse{
+			LedgerTip: ledgerTip,
+		}
+	}
+	if len(resp.Items) > 0 {
+		if err := stream.Send(resp); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ReadData returns the datums with the provided hashes. Datums are resolved
+// from the transactions of blocks recently received from the node, which are
+// followed from the tip since startup, and unknown hashes are left out of the
+// response.
+func (s *queryServiceServer) ReadData(
+	ctx context.Context,
+	req *connect.Request[query.ReadDataRequest],
+) (*connect.Response[query.ReadDataResponse], error) {
+	keys := req.Msg.GetKeys()
+	fieldMask := req.Msg.GetFieldMask()
+	log.Printf(
+		"Got a ReadData request with keys %x and fieldMask %v",
+		keys,
+		fieldMask,
+	)
+	resp := &query.ReadDataResponse{}
+
+	if len(fieldMask.GetPaths()) > 0 &&
+		!fieldMask.IsValid(&query.AnyChainDatum{}) {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			fmt.Errorf("invalid field mask %v", fieldMask.GetPaths()),
+		)
+	}
+	for _, key := range keys {
+		if len(key) != common.Blake2b256Size {
+			return nil, connect.NewError(
+				connect.CodeInvalidArgument,
+				fmt.Errorf("invalid datum hash: %x", key),
+			)
+		}
+	}
+
+	// Lease a node connection from the pool
+	lease, err := node.AcquireConnection(ctx)
+	if err != nil {
 		return nil, err
 	}
+	defer lease.Release()
 
-	// Proceed to include the UTxO in the response
-	for utxoId, utxo := range utxos.Results {
-		var aud query.AnyUtxoData
-		var audc query.AnyUtxoData_Cardano
-		aud.TxoRef = &query.TxoRef{
-			Hash:  utxoId.Hash.Bytes(),
-			Index: uint32(utxoId.Idx), // #nosec G115
+	// Get chain point (slot and hash)
+	point, err := lease.LocalStateQuery().GetChainPoint()
+	if err != nil {
+		return nil, err
+	}
+	resp.LedgerTip = &query.ChainPoint{
+		Slot: point.Slot,
+		Hash: point.Hash,
+	}
+
+	for _, key := range keys {
+		datumCbor, ok := node.LookupDatum(common.NewBlake2b256(key))
+		if !ok {
+			continue
+		}
+		var datum common.Datum
+		if _, err := cbor.Decode(datumCbor, &datum); err != nil {
+			return nil, fmt.Errorf("decode datum: %w", err)
 		}
-		aud.NativeBytes = utxo.Cbor()
-		utxoRpc, err := utxo.Utxorpc()
+		plutusData, err := convertPlutusData(datum.Data)
 		if err != nil {
+			return nil, fmt.Errorf("convert datum: %w", err)
+		}
+		value := &query.AnyChainDatum{
+			NativeBytes: datumCbor,
+			Key:         key,
+			ParsedState: &query.AnyChainDatum_Cardano{
+				Cardano: plutusData,
+			},
+		}
+		if err := applyFieldMask(value, fieldMask); err != nil {
 			return nil, err
 		}
-		audc.Cardano = utxoRpc
-		aud.ParsedState = &audc
+		resp.Values = append(resp.Values, value)
+	}
 
-		// If AssetPattern is specified, filter based on it
-		if assetPattern != nil {
-			assetFound := false
-			for _, multiasset := range audc.Cardano.GetAssets() {
-				if bytes.Equal(
-					multiasset.GetPolicyId(),
-					assetPattern.GetPolicyId(),
-				) {
-					for _, asset := range multiasset.GetAssets() {
-						if bytes.Equal(
-							asset.GetName(),
-							assetPattern.GetAssetName(),
-						) {
-							assetFound = true
-							break
-						}
-					}
-				}
-				if assetFound {
-					break
-				}
-			}
+	return connect.NewResponse(resp), nil
+}
 
-			// Asset not found; skip this UTxO
-			if !assetFound {
-				continue
-			}
-		}
-		resp.Items = append(resp.Items, &aud)
+// ReadTx returns the transaction with the provided hash. Transactions are
+// resolved from the blocks recently received from the node, which are
+// followed from the tip since startup, or from the mempool, in which case no
+// block reference is set. Transactions from before startup aren't found.
+func (s *queryServiceServer) ReadTx(
+	ctx context.Context,
+	req *connect.Request[query.ReadTxRequest],
+) (*connect.Response[query.ReadTxResponse], error) {
+	hash := req.Msg.GetHash()
+	fieldMask := req.Msg.GetFieldMask()
+	log.Printf(
+		"Got a ReadTx request with hash %x and fieldMask %v",
+		hash,
+		fieldMask,
+	)
+	resp := &query.ReadTxResponse{}
+
+	if len(fieldMask.GetPaths()) > 0 &&
+		!fieldMask.IsValid(&query.AnyChainTx{}) {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			fmt.Errorf("invalid field mask %v", fieldMask.GetPaths()),
+		)
+	}
+	if len(hash) != common.Blake2b256Size {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			fmt.Errorf("invalid transaction hash: %x", hash),
+		)
 	}
 
+	// Lease a node connection from the pool
+	lease, err := node.AcquireConnection(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer lease.Release()
+
+	// Get chain point (slot and hash)
+	point, err := lease.LocalStateQuery().GetChainPoint()
+	if err != nil {
+		return nil, err
+	}
 	resp.LedgerTip = &query.ChainPoint{
 		Slot: point.Slot,
 		Hash: point.Hash,
 	}
+
+	var tx ledger.Transaction
+	var nativeBytes []byte
+	var blockRef *query.ChainPoint
+	if indexedTx, ok := node.LookupTx(common.NewBlake2b256(hash)); ok {
+		tx, err = indexedTx.Transaction()
+		if err != nil {
+			return nil, fmt.Errorf("decode transaction: %w", err)
+		}
+		nativeBytes = indexedTx.Cbor
+		blockRef = &query.ChainPoint{
+			Slot:   indexedTx.Point.Slot,
+			Hash:   indexedTx.Point.Hash,
+			Height: indexedTx.BlockNumber,
+		}
+	} else {
+		mempool, err := readMempool(lease.LocalTxMonitor())
+		if err != nil {
+			return nil, fmt.Errorf("read mempool: %w", err)
+		}
+		idx := slices.IndexFunc(
+			mempool,
+			func(mt mempoolTx) bool {
+				return bytes.Equal(mt.tx.Hash().Bytes(), hash)
+			},
+		)
+		if idx < 0 {
+			return nil, connect.NewError(
+				connect.CodeNotFound,
+				fmt.Errorf("transaction not found: %x", hash),
+			)
+		}
+		tx = mempool[idx].tx
+		nativeBytes = mempool[idx].nativeBytes
+	}
+	cTx, err := tx.Utxorpc() // *cardano.Tx
+	if err != nil {
+		return nil, fmt.Errorf("convert transaction: %w", err)
+	}
+	resp.Tx = &query.AnyChainTx{
+		NativeBytes: nativeBytes,
+		Chain: &query.AnyChainTx_Cardano{
+			Cardano: cTx,
+		},
+		BlockRef: blockRef,
+	}
+	if err := applyFieldMask(resp.Tx, fieldMask); err != nil {
+		return nil, err
+	}
+
 	return connect.NewResponse(resp), nil
 }
 
-// StreamUtxos
+// ReadGenesis returns the Shelley genesis config of the network
+func (s *queryServiceServer) ReadGenesis(
+	ctx context.Context,
+	req *connect.Request[query.ReadGenesisRequest],
+) (*connect.Response[query.ReadGenesisResponse], error) {
+	fieldMask := req.Msg.GetFieldMask()
+	log.Printf("Got a ReadGenesis request with fieldMask %v", fieldMask)
+
+	if len(fieldMask.GetPaths()) > 0 &&
+		!fieldMask.IsValid(&query.ReadGenesisResponse{}) {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			fmt.Errorf("invalid field mask %v", fieldMask.GetPaths()),
+		)
+	}
+
+	// Lease a node connection from the pool
+	lease, err := node.AcquireConnection(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer lease.Release()
+
+	genesisConfig, err := lease.LocalStateQuery().GetGenesisConfig()
+	if err != nil {
+		return nil, err
+	}
+	genesis, err := newGenesis(genesisConfig)
+	if err != nil {
+		return nil, fmt.Errorf("convert genesis config: %w", err)
+	}
+	// The node doesn't provide the genesis hash, so it's left unset
+	resp := &query.ReadGenesisResponse{
+		Caip2: newCaip2(genesisConfig.NetworkId, genesis.GetNetworkMagic()),
+		Config: &query.ReadGenesisResponse_Cardano{
+			Cardano: genesis,
+		},
+	}
+	if err := applyFieldMask(resp, fieldMask); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+// ReadEraSummary returns the start and end of each era from the node's era
+// history, along with the protocol params of the current era
+func (s *queryServiceServer) ReadEraSummary(
+	ctx context.Context,
+	req *connect.Request[query.ReadEraSummaryRequest],
+) (*connect.Response[query.ReadEraSummaryResponse], error) {
+	fieldMask := req.Msg.GetFieldMask()
+	log.Printf("Got a ReadEraSummary request with fieldMask %v", fieldMask)
+
+	if len(fieldMask.GetPaths()) > 0 &&
+		!fieldMask.IsValid(&query.ReadEraSummaryResponse{}) {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			fmt.Errorf("invalid field mask %v", fieldMask.GetPaths()),
+		)
+	}
+
+	// Lease a node connection from the pool
+	lease, err := node.AcquireConnection(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer lease.Release()
+
+	systemStartMs, eraHistory, err := node.QueryEraHistory(
+		lease.LocalStateQuery(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	summaries, err := node.EraSummaries(systemStartMs, eraHistory)
+	if err != nil {
+		return nil, err
+	}
+	protoParams, err := lease.LocalStateQuery().GetCurrentProtocolParams()
+	if err != nil {
+		return nil, err
+	}
+	pparams, err := protoParams.Utxorpc()
+	if err != nil {
+		return nil, fmt.Errorf("convert pparams: %w", err)
+	}
+	eraSummaries, err := newEraSummaries(summaries, pparams)
+	if err != nil {
+		return nil, err
+	}
+	resp := &query.ReadEraSummaryResponse{
+		Summary: &query.ReadEraSummaryResponse_Cardano{
+			Cardano: eraSummaries,
+		},
+	}
+	if err := applyFieldMask(resp, fieldMask); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(resp), nil
+}
@@ -0,0 +1,177 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utxorpc
+
+import (
+	"context"
+	"testing"
+
+	connect "connectrpc.com/connect"
+	cardano "github.com/utxorpc/go-codegen/utxorpc/v1alpha/cardano"
+	query "github.com/utxorpc/go-codegen/utxorpc/v1alpha/query"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+func TestSearchUtxosInvalidArguments(t *testing.T) {
+	s := &queryServiceServer{}
+	testDefs := []struct {
+		name    string
+		request *query.SearchUtxosRequest
+	}{
+		{
+			name: "max items above limit",
+			request: &query.SearchUtxosRequest{
+				MaxItems: searchUtxosMaxItems + 1,
+			},
+		},
+		{
+			name: "negative max items",
+			request: &query.SearchUtxosRequest{
+				MaxItems: -1,
+			},
+		},
+		{
+			name: "invalid start token",
+			request: &query.SearchUtxosRequest{
+				StartToken: "not-a-token",
+			},
+		},
+	}
+	for _, testDef := range testDefs {
+		_, err := s.SearchUtxos(
+			context.Background(),
+			connect.NewRequest(testDef.request),
+		)
+		if connect.CodeOf(err) != connect.CodeInvalidArgument {
+			t.Fatalf(
+				"expected invalid argument error for %s, got: %v",
+				testDef.name,
+				err,
+			)
+		}
+	}
+}
+
+func TestStreamUtxosInvalidArguments(t *testing.T) {
+	s := &queryServiceServer{}
+	testDefs := []struct {
+		name    string
+		request *query.SearchUtxosRequest
+	}{
+		{
+			name: "max items above limit",
+			request: &query.SearchUtxosRequest{
+				MaxItems: searchUtxosMaxItems + 1,
+			},
+		},
+		{
+			name: "start token",
+			request: &query.SearchUtxosRequest{
+				StartToken: "0000000000000000000000000000000000000000000000000000000000000000#0",
+			},
+		},
+	}
+	for _, testDef := range testDefs {
+		err := s.StreamUtxos(
+			context.Background(),
+			connect.NewRequest(testDef.request),
+			nil,
+		)
+		if connect.CodeOf(err) != connect.CodeInvalidArgument {
+			t.Fatalf(
+				"expected invalid argument error for %s, got: %v",
+				testDef.name,
+				err,
+			)
+		}
+	}
+}
+
+func TestStreamUtxosWholeSetUnimplemented(t *testing.T) {
+	s := &queryServiceServer{}
+	request := &query.SearchUtxosRequest{
+		Predicate: &query.UtxoPredicate{
+			Match: &query.AnyUtxoPattern{
+				UtxoPattern: &query.AnyUtxoPattern_Cardano{
+					Cardano: &cardano.TxOutputPattern{
+						Asset: &cardano.AssetPattern{
+							PolicyId: make([]byte, 28),
+						},
+					},
+				},
+			},
+		},
+	}
+	err := s.StreamUtxos(
+		context.Background(),
+		connect.NewRequest(request),
+		nil,
+	)
+	if connect.CodeOf(err) != connect.CodeUnimplemented {
+		t.Fatalf("expected unimplemented error, got: %v", err)
+	}
+}
+
+func TestReadTxInvalidArguments(t *testing.T) {
+	s := &queryServiceServer{}
+	testDefs := []struct {
+		name    string
+		request *query.ReadTxRequest
+	}{
+		{
+			name: "short hash",
+			request: &query.ReadTxRequest{
+				Hash: []byte{0x01, 0x02},
+			},
+		},
+		{
+			name: "invalid field mask",
+			request: &query.ReadTxRequest{
+				Hash: make([]byte, 32),
+				FieldMask: &fieldmaskpb.FieldMask{
+					Paths: []string{"not_a_field"},
+				},
+			},
+		},
+	}
+	for _, testDef := range testDefs {
+		_, err := s.ReadTx(
+			context.Background(),
+			connect.NewRequest(testDef.request),
+		)
+		if connect.CodeOf(err) != connect.CodeInvalidArgument {
+			t.Fatalf(
+				"expected invalid argument error for %s, got: %v",
+				testDef.name,
+				err,
+			)
+		}
+	}
+}
+
+func TestReadDataInvalidArguments(t *testing.T) {
+	s := &queryServiceServer{}
+	_, err := s.ReadData(
+		context.Background(),
+		connect.NewRequest(
+			&query.ReadDataRequest{
+				Keys: [][]byte{make([]byte, 32), {0x01}},
+			},
+		),
+	)
+	if connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Fatalf("expected invalid argument error, got: %v", err)
+	}
+}
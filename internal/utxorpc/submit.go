@@ -15,30 +15,32 @@
 package utxorpc
 
 import (
+	"bytes"
 	"context"
 	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"log"
 	"log/slog"
-	"math"
 	"math/big"
 	"sort"
 	"time"
 
 	connect "connectrpc.com/connect"
 	"github.com/blinklabs-io/adder/event"
+	"github.com/blinklabs-io/cardano-node-api/internal/config"
 	"github.com/blinklabs-io/cardano-node-api/internal/node"
 	"github.com/blinklabs-io/gouroboros/ledger"
 	lcommon "github.com/blinklabs-io/gouroboros/ledger/common"
 	script "github.com/blinklabs-io/gouroboros/ledger/common/script"
-	ocommon "github.com/blinklabs-io/gouroboros/protocol/common"
 	"github.com/blinklabs-io/gouroboros/protocol/localstatequery"
 	"github.com/blinklabs-io/plutigo/cek"
 	"github.com/blinklabs-io/plutigo/data"
 	"github.com/utxorpc/go-codegen/utxorpc/v1alpha/cardano"
 	submit "github.com/utxorpc/go-codegen/utxorpc/v1alpha/submit"
 	"github.com/utxorpc/go-codegen/utxorpc/v1alpha/submit/submitconnect"
+	"google.golang.org/protobuf/types/known/structpb"
 )
 
 // submitServiceServer implements the SubmitService API
@@ -46,125 +48,6 @@ type submitServiceServer struct {
 	submitconnect.UnimplementedSubmitServiceHandler
 }
 
-// systemStartToUnixMs converts SystemStartResult to Unix milliseconds
-func systemStartToUnixMs(ss *localstatequery.SystemStartResult) int64 {
-	// SystemStart contains: Year, Day (day of year 1-366), Picoseconds (within day)
-	// Convert to Unix timestamp in milliseconds
-	year := int(ss.Year.Int64())
-	dayOfYear := ss.Day
-	picoseconds := ss.Picoseconds.Int64()
-
-	// Create time for January 1st of the year
-	t := time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
-	// Add days (dayOfYear is 1-indexed, so subtract 1)
-	t = t.AddDate(0, 0, dayOfYear-1)
-	// Add picoseconds (convert to nanoseconds first: pico = 10^-12, nano = 10^-9)
-	nanoseconds := picoseconds / 1000
-	t = t.Add(time.Duration(nanoseconds))
-
-	return t.UnixMilli()
-}
-
-func slotDurationMs(slotCount uint64, slotLengthMs int64) (int64, error) {
-	if slotCount > uint64(math.MaxInt64) {
-		return 0, fmt.Errorf("slot span %d exceeds int64 range", slotCount)
-	}
-	slotCountInt := int64(
-		slotCount,
-	) //nolint:gosec // checked against MaxInt64 above
-	if slotCountInt > math.MaxInt64/slotLengthMs {
-		return 0, fmt.Errorf(
-			"slot span %d overflows milliseconds with slot length %d",
-			slotCount,
-			slotLengthMs,
-		)
-	}
-	return slotCountInt * slotLengthMs, nil
-}
-
-func addMilliseconds(base int64, delta int64) (int64, error) {
-	if delta > 0 && base > math.MaxInt64-delta {
-		return 0, errors.New("POSIX time exceeds int64 range")
-	}
-	if delta < 0 && base < math.MinInt64-delta {
-		return 0, errors.New("POSIX time is below int64 range")
-	}
-	return base + delta, nil
-}
-
-// slotToPOSIXTime converts a slot number to POSIXTime (milliseconds since Unix epoch)
-func slotToPOSIXTime(
-	slot uint64,
-	systemStartMs int64,
-	eraHistory []localstatequery.EraHistoryResult,
-) (int64, error) {
-	if len(eraHistory) == 0 {
-		return 0, errors.New("era history is empty")
-	}
-
-	eras := make([]localstatequery.EraHistoryResult, len(eraHistory))
-	copy(eras, eraHistory)
-	sort.Slice(eras, func(i, j int) bool {
-		return eras[i].Begin.SlotNo < eras[j].Begin.SlotNo
-	})
-
-	var elapsedMs int64
-	for _, era := range eras {
-		if era.Begin.SlotNo < 0 || era.End.SlotNo < 0 {
-			return 0, fmt.Errorf(
-				"era history contains negative slot boundary: begin=%d end=%d",
-				era.Begin.SlotNo,
-				era.End.SlotNo,
-			)
-		}
-		if era.Params.SlotLength <= 0 {
-			return 0, fmt.Errorf(
-				"era history contains invalid slot length %d",
-				era.Params.SlotLength,
-			)
-		}
-
-		beginSlot := uint64(era.Begin.SlotNo) //nolint:gosec
-		endSlot := uint64(era.End.SlotNo)     //nolint:gosec
-		if endSlot <= beginSlot {
-			endSlot = ^uint64(0)
-		}
-		// The hard-fork era history reports SlotLength already in
-		// milliseconds (Ouroboros encodes it via slotLengthToMillisec), so it
-		// must not be scaled by 1000 again.
-		slotLengthMs := int64(era.Params.SlotLength)
-
-		if slot < beginSlot {
-			return 0, fmt.Errorf(
-				"slot %d is before first era boundary %d",
-				slot,
-				beginSlot,
-			)
-		}
-		if slot < endSlot {
-			deltaMs, err := slotDurationMs(slot-beginSlot, slotLengthMs)
-			if err != nil {
-				return 0, err
-			}
-			posixTime, err := addMilliseconds(systemStartMs, elapsedMs)
-			if err != nil {
-				return 0, err
-			}
-			return addMilliseconds(posixTime, deltaMs)
-		}
-		eraDurationMs, err := slotDurationMs(endSlot-beginSlot, slotLengthMs)
-		if err != nil {
-			return 0, err
-		}
-		elapsedMs, err = addMilliseconds(elapsedMs, eraDurationMs)
-		if err != nil {
-			return 0, err
-		}
-	}
-
-	return 0, fmt.Errorf("slot %d is outside era history", slot)
-}
-
 func addPlutusScriptByHash(
 	plutusScript lcommon.Script,
 	v1ScriptByHash map[string]lcommon.PlutusV1Script,
@@ -254,15 +137,15 @@ type eraHistorySlotState struct {
 }
 
 func (s eraHistorySlotState) SlotToTime(slot uint64) (time.Time, error) {
-	posixTime, err := slotToPOSIXTime(slot, s.systemStartMs, s.eraHistory)
+	posixTime, err := node.SlotToPOSIXTime(slot, s.systemStartMs, s.eraHistory)
 	if err != nil {
 		return time.Time{}, err
 	}
 	return time.UnixMilli(posixTime).UTC(), nil
 }
 
-func (eraHistorySlotState) TimeToSlot(time.Time) (uint64, error) {
-	return 0, errors.New("time-to-slot conversion is not supported")
+func (s eraHistorySlotState) TimeToSlot(t time.Time) (uint64, error) {
+	return node.POSIXTimeToSlot(t.UnixMilli(), s.systemStartMs, s.eraHistory)
 }
 
 type plutusScriptVersion uint
@@ -385,6 +268,13 @@ func buildScriptContextForVersion(
 	}
 	switch version {
 	case plutusScriptV1, plutusScriptV2:
+		switch purpose.(type) {
+		case script.ScriptPurposeVoting, script.ScriptPurposeProposing:
+			return nil, fmt.Errorf(
+				"script purpose %T isn't supported by Plutus V1 and V2 scripts",
+				purpose,
+			)
+		}
 		return script.NewScriptContextV1V2(txInfo, purpose).ToPlutusData(), nil
 	case plutusScriptV3, plutusScriptV4:
 		return script.NewScriptContextV3(
@@ -457,15 +347,153 @@ func buildScriptPurposeForContext(
 		return script.ScriptPurposeMinting{
 			PolicyId: sortedPolicies[redeemerIndex],
 		}, nil
+	case 2: // Cert
+		certs := tx.Certificates()
+		if int(redeemerIndex) >= len(certs) {
+			return nil, fmt.Errorf(
+				"cert redeemer index %d out of range (certificate count %d)",
+				redeemerIndex,
+				len(certs),
+			)
+		}
+		purpose := script.ScriptPurposeCertifying{
+			Index:       redeemerIndex,
+			Certificate: certs[redeemerIndex],
+		}
+		if purpose.ScriptHash() == (lcommon.ScriptHash{}) {
+			return nil, fmt.Errorf(
+				"certificate %d (%T) doesn't have a script credential",
+				redeemerIndex,
+				certs[redeemerIndex],
+			)
+		}
+		return purpose, nil
+	case 3: // Reward
+		addrs := sortWithdrawalsCanonically(tx.Withdrawals())
+		if int(redeemerIndex) >= len(addrs) {
+			return nil, fmt.Errorf(
+				"reward redeemer index %d out of range (withdrawal count %d)",
+				redeemerIndex,
+				len(addrs),
+			)
+		}
+		cred, ok := addrs[redeemerIndex].StakeCredential()
+		if !ok || cred.CredType != lcommon.CredentialTypeScriptHash {
+			return nil, fmt.Errorf(
+				"withdrawal %d from %s doesn't have a script credential",
+				redeemerIndex,
+				addrs[redeemerIndex].String(),
+			)
+		}
+		return script.ScriptPurposeRewarding{
+			StakeCredential: cred,
+		}, nil
+	case 4: // Voting
+		voters := sortVotersCanonically(tx.VotingProcedures())
+		if int(redeemerIndex) >= len(voters) {
+			return nil, fmt.Errorf(
+				"voting redeemer index %d out of range (voter count %d)",
+				redeemerIndex,
+				len(voters),
+			)
+		}
+		voter := voters[redeemerIndex]
+		if voter.Type != lcommon.VoterTypeConstitutionalCommitteeHotScriptHash &&
+			voter.Type != lcommon.VoterTypeDRepScriptHash {
+			return nil, fmt.Errorf(
+				"voter %d (type %d) doesn't have a script credential",
+				redeemerIndex,
+				voter.Type,
+			)
+		}
+		return script.ScriptPurposeVoting{
+			Voter: *voter,
+		}, nil
+	case 5: // Proposing
+		proposals := tx.ProposalProcedures()
+		if int(redeemerIndex) >= len(proposals) {
+			return nil, fmt.Errorf(
+				"proposing redeemer index %d out of range (proposal count %d)",
+				redeemerIndex,
+				len(proposals),
+			)
+		}
+		purpose := script.ScriptPurposeProposing{
+			Index:             redeemerIndex,
+			ProposalProcedure: proposals[redeemerIndex],
+		}
+		if purpose.ScriptHash() == (lcommon.ScriptHash{}) {
+			return nil, fmt.Errorf(
+				"proposal %d doesn't have a guardrail script",
+				redeemerIndex,
+			)
+		}
+		return purpose, nil
 	default:
 		return nil, fmt.Errorf(
-			"script purpose not supported yet for redeemer tag %d (index %d)",
+			"script purpose not supported for redeemer tag %d (index %d)",
 			redeemerTag,
 			redeemerIndex,
 		)
 	}
 }
 
+// sortWithdrawalsCanonically returns the withdrawal reward addresses in the
+// order which reward redeemer indices refer to. The ledger orders reward
+// accounts by network, then by credential, with script credentials before key
+// hashes, which differs from the order of the serialized addresses.
+func sortWithdrawalsCanonically(
+	withdrawals map[*lcommon.Address]*big.Int,
+) []*lcommon.Address {
+	sorted := make([]*lcommon.Address, 0, len(withdrawals))
+	for addr := range withdrawals {
+		sorted = append(sorted, addr)
+	}
+	isKey := func(addr *lcommon.Address) bool {
+		return addr.Type()&lcommon.AddressTypeScriptBit == 0
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].NetworkId() != sorted[j].NetworkId() {
+			return sorted[i].NetworkId() < sorted[j].NetworkId()
+		}
+		if isKey(sorted[i]) != isKey(sorted[j]) {
+			return !isKey(sorted[i])
+		}
+		hashI := sorted[i].StakeKeyHash()
+		hashJ := sorted[j].StakeKeyHash()
+		return bytes.Compare(hashI[:], hashJ[:]) < 0
+	})
+	return sorted
+}
+
+// sortVotersCanonically returns the voters in the order which voting redeemer
+// indices refer to. The ledger orders committee members, then DReps, then
+// stake pools, with script credentials before key hashes.
+func sortVotersCanonically(
+	votes lcommon.VotingProcedures,
+) []*lcommon.Voter {
+	sorted := make([]*lcommon.Voter, 0, len(votes))
+	for voter := range votes {
+		sorted = append(sorted, voter)
+	}
+	voterRank := map[uint8]int{
+		lcommon.VoterTypeConstitutionalCommitteeHotScriptHash: 0,
+		lcommon.VoterTypeConstitutionalCommitteeHotKeyHash:    1,
+		lcommon.VoterTypeDRepScriptHash:                       2,
+		lcommon.VoterTypeDRepKeyHash:                          3,
+		lcommon.VoterTypeStakingPoolKeyHash:                   4,
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		rankI := voterRank[sorted[i].Type]
+		rankJ := voterRank[sorted[j].Type]
+		if rankI != rankJ {
+			return rankI < rankJ
+		}
+		return bytes.Compare(sorted[i].Hash[:], sorted[j].Hash[:]) < 0
+	})
+	return sorted
+}
+
 func maxTxExUnitsFromProtocolParams(
 	protoParams lcommon.ProtocolParameters,
 ) (lcommon.ExUnits, error) {
@@ -539,15 +567,12 @@ func (s *submitServiceServer) SubmitTx(
 	log.Printf("Got a SubmitTx request with 1 transaction")
 	resp := &submit.SubmitTxResponse{}
 
-	// Connect to node
-	oConn, err := node.GetConnection(nil)
+	// Lease a node connection from the pool
+	lease, err := node.AcquireConnection(ctx)
 	if err != nil {
 		return nil, err
 	}
-	defer func() {
-		// Close Ouroboros connection
-		oConn.Close()
-	}()
+	defer lease.Release()
 
 	txRawBytes := txRaw.GetRaw() // raw bytes
 	txType, err := ledger.DetermineTransactionType(txRawBytes)
@@ -560,19 +585,65 @@ func (s *submitServiceServer) SubmitTx(
 		resp.Ref = []byte{}
 		return connect.NewResponse(resp), err
 	}
+	// Check the transaction against the ledger rules before the node sees it
+	if config.GetConfig().Submit.Validate {
+		if err := node.ValidateTx(lease.LocalStateQuery(), tx); err != nil {
+			resp.Ref = []byte{}
+			var validationErr *node.TxValidationError
+			if errors.As(err, &validationErr) {
+				return connect.NewResponse(resp), failedPreconditionError(
+					err,
+					validationErr,
+				)
+			}
+			return connect.NewResponse(resp), err
+		}
+	}
 	// Submit the transaction
-	err = oConn.LocalTxSubmission().Client.SubmitTx(
+	err = lease.LocalTxSubmission().SubmitTx(
 		uint16(txType), // #nosec G115
 		txRawBytes,
 	)
 	if err != nil {
 		resp.Ref = []byte{}
+		if reason, ok := node.TxRejectReasonFromError(err); ok {
+			return connect.NewResponse(resp), failedPreconditionError(
+				err,
+				reason,
+			)
+		}
 		return connect.NewResponse(resp), err
 	}
 	resp.Ref = tx.Hash().Bytes()
 	return connect.NewResponse(resp), nil
 }
 
+// failedPreconditionError returns an error for a transaction which was
+// rejected by validation or by the node, with the reason attached as a Struct
+// detail. The reason must encode to a JSON object.
+func failedPreconditionError(err error, reason any) error {
+	connectErr := connect.NewError(connect.CodeFailedPrecondition, err)
+	// Round trip through JSON to get the types which a Struct accepts
+	reasonJson, jsonErr := json.Marshal(reason)
+	if jsonErr != nil {
+		return connectErr
+	}
+	var reasonMap map[string]any
+	if jsonErr := json.Unmarshal(reasonJson, &reasonMap); jsonErr != nil {
+		return connectErr
+	}
+	reasonStruct, structErr := structpb.NewStruct(reasonMap)
+	if structErr != nil {
+		return connectErr
+	}
+	detail, detailErr := connect.NewErrorDetail(reasonStruct)
+	if detailErr != nil {
+		return connectErr
+	}
+	connectErr.AddDetail(detail)
+	return connectErr
+}
+
 // convertPlutusData converts plutigo data.PlutusData to *cardano.PlutusData
 func convertPlutusData(pd data.PlutusData) (*cardano.PlutusData, error) {
 	switch v := pd.(type) {
@@ -695,15 +766,12 @@ func (s *submitServiceServer) EvalTx(
 
 	resp := &submit.EvalTxResponse{}
 
-	// Connect to node
-	oConn, err := node.GetConnection(nil)
+	// Lease a node connection from the pool
+	lease, err := node.AcquireConnection(ctx)
 	if err != nil {
 		return connect.NewResponse(resp), err
 	}
-	defer func() {
-		// Close Ouroboros connection
-		oConn.Close()
-	}()
+	defer lease.Release()
 
 	// Parse the transaction
 	txRawBytes := txRaw.GetRaw() // raw bytes
@@ -717,8 +785,7 @@ func (s *submitServiceServer) EvalTx(
 	}
 
 	// Get protocol parameters for cost models
-	oConn.LocalStateQuery().Client.Start()
-	protoParams, err := oConn.LocalStateQuery().Client.GetCurrentProtocolParams()
+	protoParams, err := lease.LocalStateQuery().GetCurrentProtocolParams()
 	if err != nil {
 		return connect.NewResponse(resp), err
 	}
@@ -726,24 +793,17 @@ func (s *submitServiceServer) EvalTx(
 	if err != nil {
 		return connect.NewResponse(resp), err
 	}
-
-	// Get system start for slot-to-time conversion
-	systemStart, err := oConn.LocalStateQuery().Client.GetSystemStart()
+	fees, err := node.FeeParamsFromProtocolParams(protoParams)
 	if err != nil {
-		return connect.NewResponse(
-				resp,
-			), fmt.Errorf(
-				"get system start: %w",
-				err,
-			)
+		return connect.NewResponse(resp), err
 	}
-	systemStartMs := systemStartToUnixMs(systemStart)
-	eraHistory, err := oConn.LocalStateQuery().Client.GetEraHistory()
+
+	// Get system start and era history for slot-to-time conversion
+	systemStartMs, eraHistory, err := node.QueryEraHistory(
+		lease.LocalStateQuery(),
+	)
 	if err != nil {
-		return connect.NewResponse(resp), fmt.Errorf(
-			"get era history: %w",
-			err,
-		)
+		return connect.NewResponse(resp), err
 	}
 
 	// Resolve UTxOs for all inputs (regular + reference) in a single batch query
@@ -757,7 +817,7 @@ func (s *submitServiceServer) EvalTx(
 
 	resolvedUtxos := make(map[string]ledger.Utxo)
 	if len(allInputs) > 0 {
-		utxos, err := oConn.LocalStateQuery().Client.GetUTxOByTxIn(
+		utxos, err := lease.LocalStateQuery().GetUTxOByTxIn(
 			allInputs,
 		)
 		if err != nil {
@@ -800,27 +860,33 @@ func (s *submitServiceServer) EvalTx(
 		// evaluate (e.g. a simple payment, or any pre-Alonzo transaction).
 		// This is not an error: report an empty evaluation result, matching
 		// the output produced when redeemers are present but empty.
+		fee, err := fees.MinFee(
+			tx,
+			lcommon.ExUnits{},
+			node.RefScriptSize(resolvedUtxos),
+		)
+		if err != nil {
+			return connect.NewResponse(resp), fmt.Errorf(
+				"compute fee: %w",
+				err,
+			)
+		}
 		resp.Report = &submit.AnyChainEval{
 			Chain: &submit.AnyChainEval_Cardano{
 				Cardano: &cardano.TxEval{
-					Fee: &cardano.BigInt{
-						BigInt: &cardano.BigInt_Int{
-							Int: 0,
-						},
-					},
+					Fee:       lcommon.BigIntToUtxorpcBigInt(fee),
 					Redeemers: []*cardano.Redeemer{},
 				},
 			},
 		}
 		return connect.NewResponse(resp), nil
 	}
-	// Only spend and mint redeemers can be evaluated so far. Fail fast
-	// rather than returning misleading zero-cost results for the rest.
+	// Guarding redeemers can't be evaluated yet. Fail fast rather than
+	// returning misleading zero-cost results for them.
 	for key := range redeemers.Iter() {
-		if key.Tag != lcommon.RedeemerTagSpend &&
-			key.Tag != lcommon.RedeemerTagMint {
+		if key.Tag > lcommon.RedeemerTagProposing {
 			return connect.NewResponse(resp), fmt.Errorf(
-				"evaluation of redeemer tag %d is not supported yet (index %d): only spend and mint redeemers are supported",
+				"evaluation of redeemer tag %d is not supported yet (index %d)",
 				key.Tag,
 				key.Index,
 			)
@@ -921,6 +987,7 @@ func (s *submitServiceServer) EvalTx(
 	})
 
 	txEvalRedeemers := make([]*cardano.Redeemer, 0, len(pairs))
+	var totalExUnits lcommon.ExUnits
 	for _, pair := range pairs {
 		key := pair.key
 		value := pair.value
@@ -1028,14 +1095,6 @@ func (s *submitServiceServer) EvalTx(
 			})
 			policyId := sortedPolicies[key.Index]
 			scriptHashHex = hex.EncodeToString(policyId[:])
-		} else {
-			// Unreachable: non-spend/mint redeemers are rejected before
-			// this loop
-			return connect.NewResponse(resp), fmt.Errorf(
-				"evaluation of redeemer tag %d is not supported yet (index %d)",
-				key.Tag,
-				key.Index,
-			)
 		}
 		redeemerData := value.Data.Data
 		scriptPurpose, err := buildScriptPurposeForContext(
@@ -1053,6 +1112,12 @@ func (s *submitServiceServer) EvalTx(
 				err,
 			)
 		}
+		if scriptHashHex == "" {
+			// Certificate, reward, voting and proposing redeemers get their
+			// script hash from the credential in the script purpose
+			scriptHash := scriptPurpose.ScriptHash()
+			scriptHashHex = hex.EncodeToString(scriptHash[:])
+		}
 		contextRedeemer := script.Redeemer{
 			Tag:     key.Tag,
 			Index:   key.Index,
@@ -1269,11 +1334,11 @@ func (s *submitServiceServer) EvalTx(
 				evalContext,
 			)
 		} else {
-			// A spend or mint redeemer requires its Plutus script to be
-			// present in the witness set or referenced via a reference
-			// input. If it cannot be found we cannot evaluate it; returning
-			// zero execution units here would under-report the budget and
-			// mislead downstream fee/build logic, so fail instead.
+			// A redeemer requires its Plutus script to be present in the
+			// witness set or referenced via a reference input. If it cannot
+			// be found we cannot evaluate it; returning zero execution units
+			// here would under-report the budget and mislead downstream
+			// fee/build logic, so fail instead.
 			return connect.NewResponse(resp), fmt.Errorf(
 				"script not found for redeemer tag=%d index=%d script_hash=%s",
 				key.Tag,
@@ -1292,18 +1357,22 @@ func (s *submitServiceServer) EvalTx(
 		}
 		redeemer.ExUnits.Steps = uint64(exUnits.Steps)   //nolint:gosec
 		redeemer.ExUnits.Memory = uint64(exUnits.Memory) //nolint:gosec
+		totalExUnits.Steps += exUnits.Steps
+		totalExUnits.Memory += exUnits.Memory
 
 		txEvalRedeemers = append(txEvalRedeemers, redeemer)
 	}
 
+	// The fee covers the evaluated execution units rather than those set
+	// on the redeemers
+	fee, err := fees.MinFee(tx, totalExUnits, node.RefScriptSize(resolvedUtxos))
+	if err != nil {
+		return connect.NewResponse(resp), fmt.Errorf("compute fee: %w", err)
+	}
 	resp.Report = &submit.AnyChainEval{
 		Chain: &submit.AnyChainEval_Cardano{
 			Cardano: &cardano.TxEval{
-				Fee: &cardano.BigInt{
-					BigInt: &cardano.BigInt_Int{
-						Int: 0,
-					},
-				},
+				Fee:       lcommon.BigIntToUtxorpcBigInt(fee),
 				Redeemers: txEvalRedeemers,
 			},
 		},
@@ -1332,49 +1401,16 @@ func (s *submitServiceServer) WaitForTx(
 		)
 	}
 
-	// Setup event channel
-	eventChan := make(
-		chan event.Event,
-		100,
-	) // Increased buffer size for high-throughput
-	connCfg := node.ConnectionConfig{
-		ChainSyncEventChan: eventChan,
-	}
-
-	// Connect to node
-	logger.Debug("Establishing connection to Ouroboros node...")
-	oConn, err := node.GetConnection(&connCfg)
-	if err != nil {
-		logger.Error("Failed to connect to node", "error", err)
-		return err
-	}
-	defer func() {
-		logger.Debug("Closing connection to Ouroboros node.")
-		oConn.Close()
-	}()
-
-	// Get the current chain tip
-	tip, err := oConn.ChainSync().Client.GetCurrentTip()
-	if err != nil {
-		logger.Error("Error retrieving current tip", "error", err)
-		return err
-	}
-	logger.Debug("Current chain tip retrieved", "tip", tip)
-
-	// Start the sync with the node
+	// Start the sync with the node from the current tip. The follower
+	// reconnects if the node connection drops, so we keep waiting across
+	// node restarts.
 	logger.Debug("Starting chain synchronization...")
-	err = oConn.ChainSync().Client.Sync([]ocommon.Point{tip.Point})
-	if err != nil {
+	follower := node.NewChainFollower(nil)
+	if err := follower.Start(ctx); err != nil {
 		logger.Error("Error during chain synchronization", "error", err)
 		return err
 	}
-
-	// Context cancellation handling
-	go func() {
-		<-ctx.Done()
-		logger.Debug("Client canceled the request. Stopping event processing.")
-		close(eventChan)
-	}()
+	defer follower.Stop()
 
 	// Wait for events
 	logger.Debug("Waiting for transaction events...")
@@ -1383,7 +1419,7 @@ func (s *submitServiceServer) WaitForTx(
 		case <-ctx.Done():
 			logger.Info("Context canceled. Exiting event loop.")
 			return ctx.Err()
-		case evt, ok := <-eventChan:
+		case evt, ok := <-follower.EventChan():
 			if !ok {
 				logger.Error("Event channel closed unexpectedly.")
 				return errors.New("event channel closed")
@@ -1402,7 +1438,7 @@ func (s *submitServiceServer) WaitForTx(
 						logger.Info("Transaction matches reference", "hash", eventHash)
 
 						// Send confirmation response
-						err = stream.Send(&submit.WaitForTxResponse{
+						err := stream.Send(&submit.WaitForTxResponse{
 							Ref:   r,
 							Stage: submit.Stage_STAGE_CONFIRMED,
 						})
@@ -1433,23 +1469,17 @@ func (s *submitServiceServer) ReadMempool(
 	log.Printf("Got a ReadMempool request")
 	resp := &submit.ReadMempoolResponse{}
 
-	// Connect to node
-	oConn, err := node.GetConnection(nil)
+	// Lease a node connection from the pool
+	lease, err := node.AcquireConnection(ctx)
 	if err != nil {
 		return nil, err
 	}
-	defer func() {
-		// Close Ouroboros connection
-		oConn.Close()
-	}()
-
-	// Start LocalTxMonitor client
-	oConn.LocalTxMonitor().Client.Start()
+	defer lease.Release()
 
 	// Collect TX hashes from the mempool
 	mempool := []*submit.TxInMempool{}
 	for {
-		txRawBytes, err := oConn.LocalTxMonitor().Client.NextTx()
+		txRawBytes, err := lease.LocalTxMonitor().NextTx()
 		if err != nil {
 			log.Printf("ERROR: %s", err)
 			return nil, err
@@ -1483,74 +1513,36 @@ func (s *submitServiceServer) WatchMempool(
 		predicate,
 		fieldMask,
 	)
-
-	// Connect to node
-	oConn, err := node.GetConnection(nil)
-	if err != nil {
-		return err
+	if len(fieldMask.GetPaths()) > 0 &&
+		!fieldMask.IsValid(&submit.TxInMempool{}) {
+		return connect.NewError(
+			connect.CodeInvalidArgument,
+			fmt.Errorf("invalid field mask %v", fieldMask.GetPaths()),
+		)
 	}
-	defer func() {
-		// Close Ouroboros connection
-		oConn.Close()
-	}()
-
-	// Start clients
-	oConn.LocalTxMonitor().Client.Start()
 
-	// Collect TX hashes from the mempool
-	needsAcquire := false
+	// The shared poller compares mempool snapshots, sending matching
+	// transactions once when they enter the mempool and again when they
+	// leave it
+	watcher := globalMempoolPoller.subscribe(
+		newTxPredicateFromSubmit(predicate),
+		fieldMask,
+	)
+	defer globalMempoolPoller.unsubscribe(watcher)
 	for {
-		if needsAcquire {
-			err = oConn.LocalTxMonitor().Client.Acquire()
-			if err != nil {
-				log.Printf("ERROR: %s", err)
-				return err
-			}
-		}
-		txRawBytes, err := oConn.LocalTxMonitor().Client.NextTx()
-		if err != nil {
-			log.Printf("ERROR: %s", err)
-			return err
-		}
-		// No transactions in mempool, release and continue
-		if txRawBytes == nil {
-			err := oConn.LocalTxMonitor().Client.Release()
-			if err != nil {
-				log.Printf("ERROR: %s", err)
-				return err
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case records, ok := <-watcher.recordChan:
+			if !ok {
+				log.Printf("ERROR: %s", watcher.err)
+				return watcher.err
 			}
-			needsAcquire = true
-			continue
-		}
-
-		txType, err := ledger.DetermineTransactionType(txRawBytes)
-		if err != nil {
-			return err
-		}
-		tx, err := ledger.NewTransactionFromCbor(txType, txRawBytes)
-		if err != nil {
-			return err
-		}
-		cTx, err := tx.Utxorpc() // *cardano.Tx
-		if err != nil {
-			return fmt.Errorf("convert transaction: %w", err)
-		}
-		resp := &submit.WatchMempoolResponse{}
-		record := &submit.TxInMempool{
-			NativeBytes: txRawBytes,
-			Stage:       submit.Stage_STAGE_MEMPOOL,
-		}
-		resp.Tx = record
-		if string(record.GetNativeBytes()) == cTx.String() {
-			if predicate == nil {
-				err := stream.Send(resp)
-				if err != nil {
-					return err
+			for _, record := range records {
+				resp := &submit.WatchMempoolResponse{
+					Tx: record,
 				}
-			} else {
-				// TODO: filter from all Predicate types
-				err := stream.Send(resp)
-				if err != nil {
+				if err := stream.Send(resp); err != nil {
 					return err
 				}
 			}
@@ -16,17 +16,21 @@ package utxorpc
 
 import (
 	"bytes"
+	"errors"
 	"math"
 	"math/big"
 	"strings"
 	"testing"
 
+	connect "connectrpc.com/connect"
+	"github.com/blinklabs-io/cardano-node-api/internal/node"
 	"github.com/blinklabs-io/gouroboros/ledger"
 	lcommon "github.com/blinklabs-io/gouroboros/ledger/common"
 	script "github.com/blinklabs-io/gouroboros/ledger/common/script"
-	"github.com/blinklabs-io/gouroboros/protocol/localstatequery"
+	"github.com/blinklabs-io/gouroboros/ledger/conway"
 	"github.com/blinklabs-io/plutigo/data"
 	"github.com/utxorpc/go-codegen/utxorpc/v1alpha/cardano"
+	"google.golang.org/protobuf/types/known/structpb"
 )
 
 func TestBuildScriptPurposeUnsupportedTags(t *testing.T) {
@@ -36,19 +40,29 @@ func TestBuildScriptPurposeUnsupportedTags(t *testing.T) {
 		wantErr string
 	}{
 		{
-			name:    "cert redeemer returns error instead of dummy purpose",
+			name:    "cert redeemer without certificates",
 			tag:     lcommon.RedeemerTagCert,
-			wantErr: "not supported yet",
+			wantErr: "out of range",
 		},
 		{
-			name:    "reward redeemer returns error instead of dummy purpose",
+			name:    "reward redeemer without withdrawals",
 			tag:     lcommon.RedeemerTagReward,
-			wantErr: "not supported yet",
+			wantErr: "out of range",
 		},
 		{
-			name:    "unknown redeemer tag returns error",
+			name:    "voting redeemer without votes",
 			tag:     lcommon.RedeemerTagVoting,
-			wantErr: "not supported yet",
+			wantErr: "out of range",
+		},
+		{
+			name:    "proposing redeemer without proposals",
+			tag:     lcommon.RedeemerTagProposing,
+			wantErr: "out of range",
+		},
+		{
+			name:    "guarding redeemer returns error",
+			tag:     lcommon.RedeemerTagGuarding,
+			wantErr: "not supported",
 		},
 	}
 	for _, tc := range testCases {
@@ -78,6 +92,253 @@ func TestBuildScriptPurposeUnsupportedTags(t *testing.T) {
 	}
 }
 
+func TestBuildScriptPurposeCert(t *testing.T) {
+	scriptHash := lcommon.NewBlake2b224(bytes.Repeat([]byte{0xcd}, 28))
+	tx := &testTransaction{
+		certificates: []lcommon.Certificate{
+			&lcommon.StakeRegistrationCertificate{
+				CertType: uint(lcommon.CertificateTypeStakeRegistration),
+				StakeCredential: lcommon.Credential{
+					CredType:   lcommon.CredentialTypeAddrKeyHash,
+					Credential: scriptHash,
+				},
+			},
+			&lcommon.DeregistrationCertificate{
+				CertType: uint(lcommon.CertificateTypeDeregistration),
+				StakeCredential: lcommon.Credential{
+					CredType:   lcommon.CredentialTypeScriptHash,
+					Credential: scriptHash,
+				},
+				Amount: 2_000_000,
+			},
+		},
+	}
+
+	purpose, err := buildScriptPurposeForContext(
+		tx,
+		map[string]ledger.Utxo{},
+		lcommon.RedeemerTagCert,
+		1,
+		nil,
+	)
+	if err != nil {
+		t.Fatalf("buildScriptPurposeForContext() error = %v", err)
+	}
+	certifying, ok := purpose.(script.ScriptPurposeCertifying)
+	if !ok || certifying.Index != 1 {
+		t.Fatalf("unexpected purpose: %#v", purpose)
+	}
+	if certifying.ScriptHash() != lcommon.ScriptHash(scriptHash) {
+		t.Fatalf("unexpected script hash: %s", certifying.ScriptHash())
+	}
+
+	// A certificate with a key credential isn't witnessed by a script
+	_, err = buildScriptPurposeForContext(
+		tx,
+		map[string]ledger.Utxo{},
+		lcommon.RedeemerTagCert,
+		0,
+		nil,
+	)
+	if err == nil || !strings.Contains(err.Error(), "script credential") {
+		t.Fatalf("expected script credential error, got %v", err)
+	}
+}
+
+func TestBuildScriptPurposeReward(t *testing.T) {
+	newRewardAddr := func(addrType uint8, fill byte) *lcommon.Address {
+		addr, err := lcommon.NewAddressFromParts(
+			addrType,
+			lcommon.AddressNetworkTestnet,
+			nil,
+			bytes.Repeat([]byte{fill}, 28),
+		)
+		if err != nil {
+			t.Fatalf("NewAddressFromParts() error = %v", err)
+		}
+		return &addr
+	}
+	tx := &testTransaction{
+		withdrawals: map[*lcommon.Address]*big.Int{
+			newRewardAddr(lcommon.AddressTypeNoneKey, 0x01):    big.NewInt(5),
+			newRewardAddr(lcommon.AddressTypeNoneScript, 0xff): big.NewInt(0),
+			newRewardAddr(lcommon.AddressTypeNoneScript, 0x02): big.NewInt(0),
+		},
+	}
+
+	// Script credentials sort before key hashes, then by hash
+	for idx, fill := range []byte{0x02, 0xff} {
+		purpose, err := buildScriptPurposeForContext(
+			tx,
+			map[string]ledger.Utxo{},
+			lcommon.RedeemerTagReward,
+			uint32(idx), // #nosec G115
+			nil,
+		)
+		if err != nil {
+			t.Fatalf("buildScriptPurposeForContext() error = %v", err)
+		}
+		rewarding, ok := purpose.(script.ScriptPurposeRewarding)
+		if !ok {
+			t.Fatalf("unexpected purpose: %#v", purpose)
+		}
+		cred := rewarding.StakeCredential
+		if cred.CredType != lcommon.CredentialTypeScriptHash ||
+			!bytes.Equal(cred.Credential[:], bytes.Repeat([]byte{fill}, 28)) {
+			t.Fatalf("unexpected credential for index %d: %#v", idx, cred)
+		}
+	}
+
+	_, err := buildScriptPurposeForContext(
+		tx,
+		map[string]ledger.Utxo{},
+		lcommon.RedeemerTagReward,
+		2,
+		nil,
+	)
+	if err == nil || !strings.Contains(err.Error(), "script credential") {
+		t.Fatalf("expected script credential error, got %v", err)
+	}
+}
+
+func TestBuildScriptPurposeVoting(t *testing.T) {
+	newVoter := func(voterType uint8, fill byte) *lcommon.Voter {
+		voter := &lcommon.Voter{Type: voterType}
+		copy(voter.Hash[:], bytes.Repeat([]byte{fill}, 28))
+		return voter
+	}
+	tx := &testTransaction{
+		votingProcedures: lcommon.VotingProcedures{
+			newVoter(lcommon.VoterTypeDRepKeyHash, 0x01):    nil,
+			newVoter(lcommon.VoterTypeDRepScriptHash, 0x02): nil,
+			newVoter(
+				lcommon.VoterTypeConstitutionalCommitteeHotScriptHash,
+				0x03,
+			): nil,
+		},
+	}
+
+	// Committee members sort before DReps
+	for idx, fill := range []byte{0x03, 0x02} {
+		purpose, err := buildScriptPurposeForContext(
+			tx,
+			map[string]ledger.Utxo{},
+			lcommon.RedeemerTagVoting,
+			uint32(idx), // #nosec G115
+			nil,
+		)
+		if err != nil {
+			t.Fatalf("buildScriptPurposeForContext() error = %v", err)
+		}
+		voting, ok := purpose.(script.ScriptPurposeVoting)
+		if !ok || voting.Voter.Hash[0] != fill {
+			t.Fatalf("unexpected purpose for index %d: %#v", idx, purpose)
+		}
+	}
+
+	_, err := buildScriptPurposeForContext(
+		tx,
+		map[string]ledger.Utxo{},
+		lcommon.RedeemerTagVoting,
+		2,
+		nil,
+	)
+	if err == nil || !strings.Contains(err.Error(), "script credential") {
+		t.Fatalf("expected script credential error, got %v", err)
+	}
+}
+
+func TestBuildScriptPurposeProposing(t *testing.T) {
+	policyHash := bytes.Repeat([]byte{0xee}, 28)
+	tx := &testTransaction{
+		proposalProcedures: []lcommon.ProposalProcedure{
+			testProposal(t, &lcommon.InfoGovAction{
+				Type: uint(lcommon.GovActionTypeInfo),
+			}),
+			testProposal(t, &lcommon.TreasuryWithdrawalGovAction{
+				Type:        uint(lcommon.GovActionTypeTreasuryWithdrawal),
+				Withdrawals: map[*lcommon.Address]uint64{},
+				PolicyHash:  policyHash,
+			}),
+		},
+	}
+
+	purpose, err := buildScriptPurposeForContext(
+		tx,
+		map[string]ledger.Utxo{},
+		lcommon.RedeemerTagProposing,
+		1,
+		nil,
+	)
+	if err != nil {
+		t.Fatalf("buildScriptPurposeForContext() error = %v", err)
+	}
+	proposing, ok := purpose.(script.ScriptPurposeProposing)
+	if !ok || proposing.Index != 1 {
+		t.Fatalf("unexpected purpose: %#v", purpose)
+	}
+	if !bytes.Equal(proposing.ScriptHash().Bytes(), policyHash) {
+		t.Fatalf("unexpected script hash: %s", proposing.ScriptHash())
+	}
+
+	// Only parameter changes and treasury withdrawals run the guardrail
+	// script
+	_, err = buildScriptPurposeForContext(
+		tx,
+		map[string]ledger.Utxo{},
+		lcommon.RedeemerTagProposing,
+		0,
+		nil,
+	)
+	if err == nil || !strings.Contains(err.Error(), "guardrail script") {
+		t.Fatalf("expected guardrail script error, got %v", err)
+	}
+}
+
+func TestBuildScriptContextForVersionRejectsGovernancePurposes(
+	t *testing.T,
+) {
+	tx := &testTransaction{}
+	purpose := script.ScriptPurposeVoting{
+		Voter: lcommon.Voter{Type: lcommon.VoterTypeDRepScriptHash},
+	}
+	redeemer := script.Redeemer{
+		Tag:  lcommon.RedeemerTagVoting,
+		Data: data.NewConstr(0),
+	}
+	for _, version := range []plutusScriptVersion{
+		plutusScriptV1,
+		plutusScriptV2,
+	} {
+		_, err := buildScriptContextForVersion(
+			tx,
+			map[string]ledger.Utxo{},
+			purpose,
+			redeemer,
+			0,
+			nil,
+			0,
+			version,
+		)
+		if err == nil {
+			t.Fatalf("expected error for Plutus script version %d", version)
+		}
+	}
+	_, err := buildScriptContextForVersion(
+		tx,
+		map[string]ledger.Utxo{},
+		purpose,
+		redeemer,
+		0,
+		nil,
+		0,
+		plutusScriptV3,
+	)
+	if err != nil {
+		t.Fatalf("buildScriptContextForVersion() error = %v", err)
+	}
+}
+
 func TestBuildTxInfoErrorsOnUnresolvedInput(t *testing.T) {
 	input := testInput("01", 0)
 	tx := &testTransaction{
@@ -210,34 +471,6 @@ func TestConvertPlutusDataMinInt64UsesInt(t *testing.T) {
 	}
 }
 
-// TestSlotToPOSIXTimeUsesMillisecondSlotLength guards against double-scaling
-// the slot length. The hard-fork era history query already reports SlotLength
-// in milliseconds, so slotToPOSIXTime must not multiply it by 1000 again.
-func TestSlotToPOSIXTimeUsesMillisecondSlotLength(t *testing.T) {
-	const systemStartMs = int64(1_000_000)
-	const slotLengthMs = 1000 // Shelley: 1 second per slot, reported as 1000 ms
-	const slot = 100
-
-	var era localstatequery.EraHistoryResult
-	era.Begin.SlotNo = 0
-	era.End.SlotNo = 0 // open-ended final era
-	era.Params.EpochLength = 432000
-	era.Params.SlotLength = slotLengthMs
-
-	got, err := slotToPOSIXTime(
-		slot,
-		systemStartMs,
-		[]localstatequery.EraHistoryResult{era},
-	)
-	if err != nil {
-		t.Fatalf("slotToPOSIXTime() error = %v", err)
-	}
-	want := systemStartMs + int64(slot)*int64(slotLengthMs)
-	if got != want {
-		t.Fatalf("slotToPOSIXTime() = %d, want %d", got, want)
-	}
-}
-
 // TestBuildTxInfoWithdrawalUsesStakingCredential verifies the withdrawals map
 // key is a Plutus StakingCredential (StakingHash Credential =
 // Constr 0 [Constr 0 [hash]]), not a bare Credential or address.
@@ -462,10 +695,12 @@ func TestBuildScriptContextForVersionUsesLanguageSpecificTxInfo(
 
 type testTransaction struct {
 	lcommon.TransactionBodyBase
-	inputs          []lcommon.TransactionInput
-	referenceInputs []lcommon.TransactionInput
-	withdrawals     map[*lcommon.Address]*big.Int
-	certificates    []lcommon.Certificate
+	inputs             []lcommon.TransactionInput
+	referenceInputs    []lcommon.TransactionInput
+	withdrawals        map[*lcommon.Address]*big.Int
+	certificates       []lcommon.Certificate
+	votingProcedures   lcommon.VotingProcedures
+	proposalProcedures []lcommon.ProposalProcedure
 }
 
 func (t testTransaction) Fee() *big.Int {
@@ -480,6 +715,14 @@ func (t testTransaction) Certificates() []lcommon.Certificate {
 	return t.certificates
 }
 
+func (t testTransaction) VotingProcedures() lcommon.VotingProcedures {
+	return t.votingProcedures
+}
+
+func (t testTransaction) ProposalProcedures() []lcommon.ProposalProcedure {
+	return t.proposalProcedures
+}
+
 func (t testTransaction) Cbor() []byte {
 	return nil
 }
@@ -538,3 +781,101 @@ func (t testTransaction) ProtocolParameterUpdates() (
 func testInput(suffix string, index int) lcommon.TransactionInput {
 	return ledger.NewShelleyTransactionInput(strings.Repeat("00", 31)+suffix, index)
 }
+
+func testProposal(
+	t *testing.T,
+	action lcommon.GovAction,
+) lcommon.ProposalProcedure {
+	t.Helper()
+	rewardAccount, err := lcommon.NewAddressFromParts(
+		lcommon.AddressTypeNoneKey,
+		lcommon.AddressNetworkTestnet,
+		nil,
+		bytes.Repeat([]byte{0x01}, 28),
+	)
+	if err != nil {
+		t.Fatalf("NewAddressFromParts() error = %v", err)
+	}
+	proposal, err := conway.NewConwayProposalProcedure(
+		100_000_000_000,
+		rewardAccount,
+		action,
+		lcommon.GovAnchor{},
+	)
+	if err != nil {
+		t.Fatalf("NewConwayProposalProcedure() error = %v", err)
+	}
+	return proposal
+}
+
+func TestTxRejectedErrorDetails(t *testing.T) {
+	reason := &node.TxRejectReason{
+		Type: "FeeTooSmallUtxo",
+		Details: map[string]any{
+			"minimumFee":  uint64(170000),
+			"suppliedFee": uint64(150000),
+		},
+	}
+	err := failedPreconditionError(errors.New("rejected"), reason)
+	var connectErr *connect.Error
+	if !errors.As(err, &connectErr) {
+		t.Fatalf("did not get connect error: %s", err)
+	}
+	if connectErr.Code() != connect.CodeFailedPrecondition {
+		t.Fatalf("did not get expected code: %s", connectErr.Code())
+	}
+	if len(connectErr.Details()) != 1 {
+		t.Fatalf("did not get expected details: %v", connectErr.Details())
+	}
+	detail, err := connectErr.Details()[0].Value()
+	if err != nil {
+		t.Fatalf("unexpected error decoding detail: %s", err)
+	}
+	reasonStruct, ok := detail.(*structpb.Struct)
+	if !ok {
+		t.Fatalf("did not get expected detail type: %T", detail)
+	}
+	reasonMap := reasonStruct.AsMap()
+	if reasonMap["type"] != "FeeTooSmallUtxo" {
+		t.Fatalf("did not get expected type: %v", reasonMap["type"])
+	}
+	details, _ := reasonMap["details"].(map[string]any)
+	if details["minimumFee"] != float64(170000) {
+		t.Fatalf("did not get expected details: %v", details)
+	}
+}
+
+func TestTxValidationErrorDetails(t *testing.T) {
+	validationErr := &node.TxValidationError{
+		Failures: []node.TxValidationFailure{
+			{Rule: "FeeTooSmall", Message: "fee too small"},
+		},
+	}
+	err := failedPreconditionError(validationErr, validationErr)
+	var connectErr *connect.Error
+	if !errors.As(err, &connectErr) {
+		t.Fatalf("did not get connect error: %s", err)
+	}
+	if connectErr.Code() != connect.CodeFailedPrecondition {
+		t.Fatalf("did not get expected code: %s", connectErr.Code())
+	}
+	if len(connectErr.Details()) != 1 {
+		t.Fatalf("did not get expected details: %v", connectErr.Details())
+	}
+	detail, err := connectErr.Details()[0].Value()
+	if err != nil {
+		t.Fatalf("unexpected error decoding detail: %s", err)
+	}
+	failuresStruct, ok := detail.(*structpb.Struct)
+	if !ok {
+		t.Fatalf("did not get expected detail type: %T", detail)
+	}
+	failures, _ := failuresStruct.AsMap()["failures"].([]any)
+	if len(failures) != 1 {
+		t.Fatalf("did not get expected failures: %v", failures)
+	}
+	failure, _ := failures[0].(map[string]any)
+	if failure["rule"] != "FeeTooSmall" {
+		t.Fatalf("did not get expected rule: %v", failure["rule"])
+	}
+}
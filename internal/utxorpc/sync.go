@@ -16,6 +16,7 @@ package utxorpc
 
 import (
 	"context"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"log"
@@ -23,9 +24,17 @@ import (
 	connect "connectrpc.com/connect"
 	"github.com/blinklabs-io/adder/event"
 	"github.com/blinklabs-io/cardano-node-api/internal/node"
+	"github.com/blinklabs-io/gouroboros/ledger"
+	"github.com/blinklabs-io/gouroboros/protocol/chainsync"
 	ocommon "github.com/blinklabs-io/gouroboros/protocol/common"
 	sync "github.com/utxorpc/go-codegen/utxorpc/v1alpha/sync"
 	"github.com/utxorpc/go-codegen/utxorpc/v1alpha/sync/syncconnect"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+const (
+	dumpHistoryDefaultItems = 100
+	dumpHistoryMaxItems     = 1000
 )
 
 // chainSyncServiceServer implements the ChainSyncService API
@@ -46,57 +55,78 @@ func (s *chainSyncServiceServer) FetchBlock(
 		fieldMask,
 	)
 
-	// Connect to node
-	oConn, err := node.GetConnection(nil)
-	if err != nil {
-		return nil, err
-	}
-	defer func() {
-		// Close Ouroboros connection
-		oConn.Close()
-	}()
-
-	resp := &sync.FetchBlockResponse{}
-	// Start client
+	// Default to the block at the chain tip
 	var points []ocommon.Point
 	if len(ref) > 0 {
 		for _, blockRef := range ref {
-			blockIdx := blockRef.GetSlot()
-			blockHash := blockRef.GetHash()
-			slot := uint64(blockIdx)
-			point := ocommon.NewPoint(slot, blockHash)
-			points = append(points, point)
+			points = append(
+				points,
+				ocommon.NewPoint(blockRef.GetSlot(), blockRef.GetHash()),
+			)
 		}
 	} else {
-		tip, err := oConn.ChainSync().Client.GetCurrentTip()
+		// Lease a node connection from the pool
+		lease, err := node.AcquireConnection(ctx)
 		if err != nil {
 			return nil, err
 		}
-		point := tip.Point
-		points = append(points, point)
+		tip, err := lease.ChainSync().GetCurrentTip()
+		lease.Release()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get tip: %w", err)
+		}
+		points = append(points, tip.Point)
+	}
+
+	blocks, err := node.FetchBlocks(ctx, points)
+	if err != nil {
+		if errors.Is(err, node.ErrBlockNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
+		if errors.Is(err, node.ErrBlockUnreachable) {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+		}
+		return nil, err
+	}
+
+	resp := &sync.FetchBlockResponse{}
+	for _, block := range blocks {
+		acb, err := newAnyChainBlock(block, fieldMask)
+		if err != nil {
+			return nil, err
+		}
+		resp.Block = append(resp.Block, acb)
 	}
-	log.Printf("points: %v", points)
-	// TODO: replace with something that works NtC
-	// for _, point := range points {
-	// 	log.Printf("Point Slot: %d, Hash: %x\n", point.Slot, point.Hash)
-	// 	block, err := oConn.BlockFetch().Client.GetBlock(
-	// 		ocommon.NewPoint(point.Slot, point.Hash),
-	// 	)
-	// 	if err != nil {
-	// 		return nil, err
-	// 	}
-	// 	var acb sync.AnyChainBlock
-	// 	var acbc sync.AnyChainBlock_Cardano
-	// 	ret := NewBlockFromBlock(block)
-	// 	acbc.Cardano = &ret
-	// 	acb.Chain = &acbc
-	// 	resp.Block = append(resp.Block, &acb)
-	// }
 
 	return connect.NewResponse(resp), nil
 }
 
-// DumpHistory
+// newAnyChainBlock converts a block for the sync service, keeping only the
+// fields selected by the field mask
+func newAnyChainBlock(
+	block ledger.Block,
+	fieldMask *fieldmaskpb.FieldMask,
+) (*sync.AnyChainBlock, error) {
+	tmpBlock, err := block.Utxorpc()
+	if err != nil {
+		return nil, fmt.Errorf("convert block: %w", err)
+	}
+	acb := &sync.AnyChainBlock{
+		NativeBytes: block.Cbor(),
+		Chain: &sync.AnyChainBlock_Cardano{
+			Cardano: tmpBlock,
+		},
+	}
+	if err := applyFieldMask(acb, fieldMask); err != nil {
+		return nil, err
+	}
+	return acb, nil
+}
+
+// DumpHistory returns the blocks of the chain in order, a page at a time.
+// The page starts after the block given as the start token, or with the first
+// block of the chain when there's no token. The next token is the last block
+// of the page, so paging doesn't depend on any state kept by this process.
 func (s *chainSyncServiceServer) DumpHistory(
 	ctx context.Context,
 	req *connect.Request[sync.DumpHistoryRequest],
@@ -111,49 +141,56 @@ func (s *chainSyncServiceServer) DumpHistory(
 		fieldMask,
 	)
 
-	// Connect to node
-	oConn, err := node.GetConnection(nil)
+	pageSize := int(maxItems)
+	if pageSize == 0 {
+		pageSize = dumpHistoryDefaultItems
+	} else if pageSize > dumpHistoryMaxItems {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			fmt.Errorf(
+				"max_items must be at most %d",
+				dumpHistoryMaxItems,
+			),
+		)
+	}
+
+	// Start at the first block of the chain if no start token was provided,
+	// and after the start token otherwise
+	var startPoint *ocommon.Point
+	if startToken != nil {
+		tmpPoint := ocommon.NewPoint(
+			startToken.GetSlot(),
+			startToken.GetHash(),
+		)
+		startPoint = &tmpPoint
+	}
+
+	// Read an extra block to find out whether there's a next page
+	blocks, err := node.ReadBlocks(ctx, startPoint, pageSize+1)
 	if err != nil {
+		if errors.Is(err, node.ErrBlockNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
 		return nil, err
 	}
-	defer func() {
-		// Close Ouroboros connection
-		oConn.Close()
-	}()
 
 	resp := &sync.DumpHistoryResponse{}
-	// Start client
-	log.Printf("startToken: %#v\n", startToken)
-	var startPoint ocommon.Point
-	if startToken != nil {
-		log.Printf("startToken != nil\n")
-		blockRef := startToken
-		blockIdx := blockRef.GetSlot()
-		blockHash := blockRef.GetHash()
-		slot := uint64(blockIdx)
-		startPoint = ocommon.NewPoint(slot, blockHash)
-	} else {
-		log.Printf("getting tip\n")
-		tip, err := oConn.ChainSync().Client.GetCurrentTip()
+	if len(blocks) > pageSize {
+		blocks = blocks[:pageSize]
+		lastBlock := blocks[pageSize-1]
+		resp.NextToken = &sync.BlockRef{
+			Slot:   lastBlock.SlotNumber(),
+			Hash:   lastBlock.Hash().Bytes(),
+			Height: lastBlock.BlockNumber(),
+		}
+	}
+	for _, block := range blocks {
+		acb, err := newAnyChainBlock(block, fieldMask)
 		if err != nil {
 			return nil, err
 		}
-		startPoint = tip.Point
+		resp.Block = append(resp.Block, acb)
 	}
-	log.Printf(
-		"startPoint slot %d, hash %x\n",
-		startPoint.Slot,
-		startPoint.Hash,
-	)
-	// TODO: why is this giving us 0?
-	start, end, err := oConn.ChainSync().Client.GetAvailableBlockRange(
-		[]ocommon.Point{startPoint},
-	)
-	if err != nil {
-		return nil, err
-	}
-	log.Printf("Start:     slot %d, hash %x\n", start.Slot, start.Hash)
-	log.Printf("End (tip): slot %d, hash %x\n", end.Slot, end.Hash)
 
 	return connect.NewResponse(resp), nil
 }
@@ -165,17 +202,14 @@ func (s *chainSyncServiceServer) ReadTip(
 ) (*connect.Response[sync.ReadTipResponse], error) {
 	log.Printf("Got a ReadTip request")
 
-	// Connect to node
-	oConn, err := node.GetConnection(nil)
+	// Lease a node connection from the pool
+	lease, err := node.AcquireConnection(ctx)
 	if err != nil {
 		return nil, err
 	}
-	defer func() {
-		// Close Ouroboros connection
-		oConn.Close()
-	}()
+	defer lease.Release()
 
-	tip, err := oConn.ChainSync().Client.GetCurrentTip()
+	tip, err := lease.ChainSync().GetCurrentTip()
 	if err != nil {
 		return nil, fmt.Errorf("failed to get tip: %w", err)
 	}
@@ -206,61 +240,124 @@ func (s *chainSyncServiceServer) FollowTip(
 	stream *connect.ServerStream[sync.FollowTipResponse],
 ) error {
 	intersect := req.Msg.GetIntersect() // []*BlockRef
-	log.Printf("Got a FollowTip request with intersect %v", intersect)
-
-	// Setup event channel
-	eventChan := make(chan event.Event, 10)
-	connCfg := node.ConnectionConfig{
-		ChainSyncEventChan: eventChan,
-	}
-	// Connect to node
-	oConn, err := node.GetConnection(&connCfg)
-	if err != nil {
-		return err
+	fieldMask := req.Msg.GetFieldMask()
+	log.Printf(
+		"Got a FollowTip request with intersect %v and fieldMask %v",
+		intersect,
+		fieldMask,
+	)
+	if len(fieldMask.GetPaths()) > 0 &&
+		!fieldMask.IsValid(&sync.AnyChainBlock{}) {
+		return connect.NewError(
+			connect.CodeInvalidArgument,
+			fmt.Errorf("invalid field mask %v", fieldMask.GetPaths()),
+		)
 	}
-	defer func() {
-		// Close Ouroboros connection
-		oConn.Close()
-	}()
 
-	// Get our starting point
-	var point ocommon.Point
-	if len(intersect) > 0 {
-		for _, blockRef := range intersect {
-			blockIdx := blockRef.GetSlot()
-			blockHash := blockRef.GetHash()
-			log.Printf("BlockRef: idx: %d, hash: %x", blockIdx, blockHash)
-			slot := uint64(blockIdx)
-			point = ocommon.NewPoint(slot, blockHash)
-		}
-	} else {
-		tip, err := oConn.ChainSync().Client.GetCurrentTip()
-		if err != nil {
-			return fmt.Errorf("failed to get tip: %w", err)
-		}
-		if tip != nil {
-			point = tip.Point
-		}
+	// Get our starting points, in order of preference. The follower starts
+	// at the current tip when no intersect is provided.
+	points := make([]ocommon.Point, 0, len(intersect))
+	for _, blockRef := range intersect {
+		points = append(
+			points,
+			ocommon.NewPoint(blockRef.GetSlot(), blockRef.GetHash()),
+		)
 	}
 
 	// Start the sync with the node
-	err = oConn.ChainSync().Client.Sync([]ocommon.Point{point})
-	if err != nil {
+	follower := node.NewChainFollower(points)
+	if err := follower.Start(ctx); err != nil {
 		log.Printf("ERROR: %s", err)
+		if errors.Is(err, chainsync.ErrIntersectNotFound) {
+			return connect.NewError(connect.CodeNotFound, err)
+		}
 		return err
 	}
+	defer follower.Stop()
+
+	// Applied blocks are kept so that rollbacks can be sent as Undo actions
+	history := newBlockHistory[ledger.Block](rollbackHistorySize)
 
 	// Wait for events
 	for {
-		evt, ok := <-eventChan
+		evt, ok := <-follower.EventChan()
 		if !ok {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
 			log.Printf("ERROR: channel closed")
 			return errors.New("ERROR: channel closed")
 		}
 
 		switch evt.Type {
+		case node.EventTypeResync:
+			// The node connection was re-established, so tell the client
+			// which block the stream resumes after
+			re := evt.Payload.(node.ResyncEvent)
+			hash, err := hex.DecodeString(re.BlockHash)
+			if err != nil {
+				return fmt.Errorf("decode resync hash: %w", err)
+			}
+			resp := &sync.FollowTipResponse{
+				Action: &sync.FollowTipResponse_Reset_{
+					Reset_: &sync.BlockRef{
+						Slot: re.SlotNumber,
+						Hash: hash,
+					},
+				},
+			}
+			if err := stream.Send(resp); err != nil {
+				return err
+			}
+		case "chainsync.rollback":
+			re := evt.Payload.(event.RollbackEvent)
+			hash, err := hex.DecodeString(re.BlockHash)
+			if err != nil {
+				return fmt.Errorf("decode rollback hash: %w", err)
+			}
+			point := ocommon.NewPoint(re.SlotNumber, hash)
+			undoBlocks, ok := history.rollback(point)
+			if !ok {
+				// The first rollback reports the intersection. Any other
+				// rollback past our history requires the client to reset.
+				resp := &sync.FollowTipResponse{
+					Action: &sync.FollowTipResponse_Reset_{
+						Reset_: &sync.BlockRef{
+							Slot: point.Slot,
+							Hash: point.Hash,
+						},
+					},
+				}
+				if err := stream.Send(resp); err != nil {
+					return err
+				}
+				log.Printf(
+					"reset: slot: %d, hash: %x",
+					point.Slot,
+					point.Hash,
+				)
+				continue
+			}
+			for _, block := range undoBlocks {
+				acb, err := newAnyChainBlock(block, fieldMask)
+				if err != nil {
+					return err
+				}
+				resp := &sync.FollowTipResponse{
+					Action: &sync.FollowTipResponse_Undo{
+						Undo: acb,
+					},
+				}
+				if err := stream.Send(resp); err != nil {
+					return err
+				}
+				log.Printf(
+					"undo: slot: %d, hash: %s",
+					block.SlotNumber(),
+					block.Hash(),
+				)
+			}
 		case "chainsync.block":
-			resp := &sync.FollowTipResponse{}
 			// Get event context to get the block chain information
 			context := evt.Context
 			if context == nil {
@@ -285,22 +382,22 @@ func (s *chainSyncServiceServer) FollowTip(
 			be := payload.(event.BlockEvent)
 			block := be.Block // gOuroboros Block
 
-			var acb sync.AnyChainBlock
-			tmpBlock, err := block.Utxorpc()
+			acb, err := newAnyChainBlock(block, fieldMask)
 			if err != nil {
-				return fmt.Errorf("convert block: %w", err)
+				return err
 			}
-			acbc := sync.AnyChainBlock_Cardano{
-				Cardano: tmpBlock,
+			resp := &sync.FollowTipResponse{
+				Action: &sync.FollowTipResponse_Apply{
+					Apply: acb,
+				},
 			}
-			acb.Chain = &acbc
-			var ftra sync.FollowTipResponse_Apply
-			ftra.Apply = &acb
-			resp.Action = &ftra
-			err = stream.Send(resp)
-			if err != nil {
+			if err := stream.Send(resp); err != nil {
 				return err
 			}
+			history.push(
+				ocommon.NewPoint(block.SlotNumber(), block.Hash().Bytes()),
+				block,
+			)
 			// Log event
 			log.Printf(
 				"block: slot: %d, hash: %s",
@@ -0,0 +1,36 @@
+// Copyright 2026 Blink Labs Software
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utxorpc
+
+import (
+	"context"
+	"testing"
+
+	connect "connectrpc.com/connect"
+	sync "github.com/utxorpc/go-codegen/utxorpc/v1alpha/sync"
+)
+
+func TestDumpHistoryMaxItems(t *testing.T) {
+	s := &chainSyncServiceServer{}
+	_, err := s.DumpHistory(
+		context.Background(),
+		connect.NewRequest(&sync.DumpHistoryRequest{
+			MaxItems: dumpHistoryMaxItems + 1,
+		}),
+	)
+	if connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Fatalf("expected invalid argument error, got: %v", err)
+	}
+}
@@ -15,19 +15,31 @@
 package utxorpc
 
 import (
+	"bytes"
 	"context"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"log"
+	"slices"
 
 	connect "connectrpc.com/connect"
 	"github.com/blinklabs-io/adder/event"
 	"github.com/blinklabs-io/cardano-node-api/internal/node"
+	"github.com/blinklabs-io/gouroboros/ledger"
+	lcommon "github.com/blinklabs-io/gouroboros/ledger/common"
+	"github.com/blinklabs-io/gouroboros/protocol/chainsync"
 	ocommon "github.com/blinklabs-io/gouroboros/protocol/common"
+	"github.com/utxorpc/go-codegen/utxorpc/v1alpha/cardano"
 	watch "github.com/utxorpc/go-codegen/utxorpc/v1alpha/watch"
 	"github.com/utxorpc/go-codegen/utxorpc/v1alpha/watch/watchconnect"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 )
 
+// Number of transaction outputs remembered by WatchTx streams for resolving
+// the inputs of later transactions
+const watchTxOutputCacheSize = 50000
+
 // watchServiceServer implements the WatchService API
 type watchServiceServer struct {
 	watchconnect.UnimplementedWatchServiceHandler
@@ -41,50 +53,127 @@ func (s *watchServiceServer) WatchTx(
 ) error {
 	predicate := req.Msg.GetPredicate() // Predicate
 	fieldMask := req.Msg.GetFieldMask()
+	intersect := req.Msg.GetIntersect() // []*BlockRef
 	log.Printf(
-		"Got a WatchTx request with predicate %v and fieldMask %v",
+		"Got a WatchTx request with predicate %v, fieldMask %v and intersect %v",
 		predicate,
 		fieldMask,
+		intersect,
 	)
-
-	// Setup event channel
-	eventChan := make(chan event.Event, 10)
-	connCfg := node.ConnectionConfig{
-		ChainSyncEventChan: eventChan,
-	}
-	// Connect to node
-	oConn, err := node.GetConnection(&connCfg)
-	if err != nil {
-		log.Printf("ERROR: %s", err)
-		return err
+	if len(fieldMask.GetPaths()) > 0 &&
+		!fieldMask.IsValid(&watch.AnyChainTx{}) {
+		return connect.NewError(
+			connect.CodeInvalidArgument,
+			fmt.Errorf("invalid field mask %v", fieldMask.GetPaths()),
+		)
 	}
-	defer func() {
-		// Close Ouroboros connection
-		oConn.Close()
-	}()
+	txPredicate := newTxPredicateFromWatch(predicate)
 
-	// Get current tip
-	tip, err := oConn.ChainSync().Client.GetCurrentTip()
-	if err != nil {
-		log.Printf("ERROR: %s", err)
-		return err
+	// Get our starting points, in order of preference. The follower starts
+	// at the current tip when no intersect is provided.
+	points := make([]ocommon.Point, 0, len(intersect))
+	for _, blockRef := range intersect {
+		points = append(
+			points,
+			ocommon.NewPoint(blockRef.GetSlot(), blockRef.GetHash()),
+		)
 	}
+
 	// Start the sync with the node
-	err = oConn.ChainSync().Client.Sync([]ocommon.Point{tip.Point})
-	if err != nil {
+	follower := node.NewChainFollower(points)
+	if err := follower.Start(ctx); err != nil {
 		log.Printf("ERROR: %s", err)
+		if errors.Is(err, chainsync.ErrIntersectNotFound) {
+			return connect.NewError(connect.CodeNotFound, err)
+		}
 		return err
 	}
+	defer follower.Stop()
+
+	// The ledger at the tip no longer has the outputs spent by the
+	// transactions we see, so inputs are resolved against the outputs of
+	// earlier transactions in the stream, and otherwise against the ledger
+	// state before the block
+	outputs := newOutputCache(watchTxOutputCacheSize)
+	resolveInputs := txPredicate.resolvesInputs()
+	// Failures are only logged once until resolving succeeds again, as
+	// every block fails while catching up from an older intersect
+	resolveFailing := false
+	// The point of the last block applied, which is the parent of the next
+	var prevPoint ocommon.Point
+	// The first rollback reports the intersection
+	intersected := false
+	// Matching transactions are kept so that rollbacks can be sent as Undo
+	// actions
+	history := newBlockHistory[[]*watch.AnyChainTx](rollbackHistorySize)
 
 	// Wait for events
 	for {
-		evt, ok := <-eventChan
+		evt, ok := <-follower.EventChan()
 		if !ok {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
 			log.Printf("ERROR: channel closed")
 			return errors.New("ERROR: channel closed")
 		}
 
 		switch evt.Type {
+		case "chainsync.rollback":
+			re := evt.Payload.(event.RollbackEvent)
+			hash, err := hex.DecodeString(re.BlockHash)
+			if err != nil {
+				return fmt.Errorf("decode rollback hash: %w", err)
+			}
+			point := ocommon.NewPoint(re.SlotNumber, hash)
+			if !intersected {
+				intersected = true
+				prevPoint = point
+				log.Printf(
+					"intersect: slot: %d, hash: %x",
+					point.Slot,
+					point.Hash,
+				)
+				continue
+			}
+			undoBlocks, ok := history.rollback(point)
+			if !ok {
+				// Nothing was applied since the intersection
+				if point.Slot == prevPoint.Slot &&
+					bytes.Equal(point.Hash, prevPoint.Hash) {
+					continue
+				}
+				// There's no way to tell the client about a rollback past
+				// our history, so the stream ends for the client to
+				// intersect again
+				return connect.NewError(
+					connect.CodeAborted,
+					fmt.Errorf(
+						"rollback past the last %d blocks: slot %d, hash %x",
+						rollbackHistorySize,
+						point.Slot,
+						point.Hash,
+					),
+				)
+			}
+			prevPoint = point
+			if len(undoBlocks) > 0 {
+				// Outputs from the undone blocks are no longer on the chain
+				outputs.clear()
+			}
+			for _, txs := range undoBlocks {
+				// Undo transactions in the reverse order they were applied
+				for i := len(txs) - 1; i >= 0; i-- {
+					resp := &watch.WatchTxResponse{
+						Action: &watch.WatchTxResponse_Undo{
+							Undo: txs[i],
+						},
+					}
+					if err := stream.Send(resp); err != nil {
+						return err
+					}
+				}
+			}
 		case "chainsync.block":
 			// Get event context to get the block chain information
 			context := evt.Context
@@ -108,38 +197,160 @@ func (s *watchServiceServer) WatchTx(
 				)
 			}
 			be := payload.(event.BlockEvent)
-			block := be.Block // gOuorboros Block
+			block := be.Block // gOuroboros Block
+
+			resolve := outputs.resolve
+			if resolveInputs {
+				ledgerOutputs, err := resolveBlockInputs(
+					ctx,
+					prevPoint,
+					block,
+					outputs,
+				)
+				// Only the inputs known to the cache can be matched when
+				// this fails
+				if err != nil && !resolveFailing {
+					log.Printf(
+						"ERROR: failed to resolve inputs: block: %d, slot: %d: %s",
+						bc.BlockNumber,
+						bc.SlotNumber,
+						err,
+					)
+				}
+				resolveFailing = err != nil
+				resolve = func(
+					input lcommon.TransactionInput,
+				) lcommon.TransactionOutput {
+					if output := outputs.resolve(input); output != nil {
+						return output
+					}
+					return ledgerOutputs[outputCacheKey(input)]
+				}
+			}
+			prevPoint = ocommon.NewPoint(
+				block.SlotNumber(),
+				block.Hash().Bytes(),
+			)
 
 			// Loop through transactions
+			var applied []*watch.AnyChainTx
 			for _, tx := range block.Transactions() {
-				tmpTx, err := tx.Utxorpc()
+				match := txPredicate.matches(tx, resolve)
+				outputs.update(tx)
+				if !match {
+					continue
+				}
+				act, err := newWatchAnyChainTx(block, tx, fieldMask)
 				if err != nil {
-					return fmt.Errorf("convert transaction: %w", err)
+					return err
 				}
-				resp := &watch.WatchTxResponse{}
-				var act watch.AnyChainTx
-				actc := watch.AnyChainTx_Cardano{
-					Cardano: tmpTx,
+				resp := &watch.WatchTxResponse{
+					Action: &watch.WatchTxResponse_Apply{
+						Apply: act,
+					},
 				}
-				act.Chain = &actc
-				var wtra watch.WatchTxResponse_Apply
-				wtra.Apply = &act
-				resp.Action = &wtra
-				if predicate == nil {
-					err := stream.Send(resp)
-					if err != nil {
-						return err
-					}
-				} else {
-					// TODO: filter from all Predicate types
-					err := stream.Send(resp)
-					if err != nil {
-						return err
-					}
+				if err := stream.Send(resp); err != nil {
+					return err
 				}
+				applied = append(applied, act)
 			}
+			// Let the client know that the block had no matches
+			if len(applied) == 0 {
+				resp := &watch.WatchTxResponse{
+					Action: &watch.WatchTxResponse_Idle{
+						Idle: &watch.BlockRef{
+							Slot:   block.SlotNumber(),
+							Hash:   block.Hash().Bytes(),
+							Height: block.BlockNumber(),
+						},
+					},
+				}
+				if err := stream.Send(resp); err != nil {
+					return err
+				}
+			}
+			history.push(
+				ocommon.NewPoint(block.SlotNumber(), block.Hash().Bytes()),
+				applied,
+			)
+			// Log event
+			log.Printf(
+				"block: slot: %d, hash: %s, matches: %d",
+				block.SlotNumber(),
+				block.Hash(),
+				len(applied),
+			)
 		}
-		// Log event
-		log.Printf("event: %v", evt)
 	}
 }
+
+// resolveBlockInputs looks up the outputs spent by the transactions of a block
+// which aren't in the cache, in the ledger state at the parent of the block.
+// The node only keeps the ledger states of recent blocks, so this fails for
+// blocks further back.
+func resolveBlockInputs(
+	ctx context.Context,
+	parent ocommon.Point,
+	block ledger.Block,
+	outputs *outputCache,
+) (map[string]lcommon.TransactionOutput, error) {
+	var txIns []ledger.TransactionInput
+	for _, tx := range block.Transactions() {
+		for _, input := range slices.Concat(tx.Inputs(), tx.Collateral()) {
+			if outputs.resolve(input) == nil {
+				txIns = append(txIns, input)
+			}
+		}
+	}
+	if len(txIns) == 0 {
+		return nil, nil
+	}
+	// Lease a node connection from the pool
+	lease, err := node.AcquireConnection(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer lease.Release()
+	utxos, err := node.UtxosByTxInAt(lease.LocalStateQuery(), parent, txIns)
+	if err != nil {
+		return nil, err
+	}
+	ret := make(map[string]lcommon.TransactionOutput, len(utxos))
+	for _, utxo := range utxos {
+		ret[outputCacheKey(utxo.Id)] = utxo.Output
+	}
+	return ret, nil
+}
+
+// newWatchAnyChainTx returns the transaction along with a reference to the
+// block containing it, with the field mask applied
+func newWatchAnyChainTx(
+	block ledger.Block,
+	tx ledger.Transaction,
+	fieldMask *fieldmaskpb.FieldMask,
+) (*watch.AnyChainTx, error) {
+	tmpTx, err := tx.Utxorpc()
+	if err != nil {
+		return nil, fmt.Errorf("convert transaction: %w", err)
+	}
+	ret := &watch.AnyChainTx{
+		Chain: &watch.AnyChainTx_Cardano{
+			Cardano: tmpTx,
+		},
+		Block: &watch.AnyChainBlock{
+			Chain: &watch.AnyChainBlock_Cardano{
+				Cardano: &cardano.Block{
+					Header: &cardano.BlockHeader{
+						Slot:   block.SlotNumber(),
+						Hash:   block.Hash().Bytes(),
+						Height: block.BlockNumber(),
+					},
+				},
+			},
+		},
+	}
+	if err := applyFieldMask(ret, fieldMask); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
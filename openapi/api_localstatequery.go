@@ -56,6 +56,8 @@ type LocalstatequeryAPI interface {
 	/*
 		LocalstatequeryGenesisConfigGet Query Genesis Config
 
+		Query the Shelley genesis config
+
 		@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
 		@return LocalstatequeryAPILocalstatequeryGenesisConfigGetRequest
 	*/
@@ -64,14 +66,16 @@ type LocalstatequeryAPI interface {
 	) LocalstatequeryAPILocalstatequeryGenesisConfigGetRequest
 
 	// LocalstatequeryGenesisConfigGetExecute executes the request
-	//  @return map[string]interface{}
+	//  @return ApiResponseLocalStateQueryGenesisConfig
 	LocalstatequeryGenesisConfigGetExecute(
 		r LocalstatequeryAPILocalstatequeryGenesisConfigGetRequest,
-	) (map[string]interface{}, *http.Response, error)
+	) (*ApiResponseLocalStateQueryGenesisConfig, *http.Response, error)
 
 	/*
 		LocalstatequeryProtocolParamsGet Query Current Protocol Parameters
 
+		Query the protocol parameters for the current era. Alonzo and later eras are supported, and fields which do not exist in the current era are omitted.
+
 		@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
 		@return LocalstatequeryAPILocalstatequeryProtocolParamsGetRequest
 	*/
@@ -80,10 +84,10 @@ type LocalstatequeryAPI interface {
 	) LocalstatequeryAPILocalstatequeryProtocolParamsGetRequest
 
 	// LocalstatequeryProtocolParamsGetExecute executes the request
-	//  @return map[string]interface{}
+	//  @return ApiResponseLocalStateQueryProtocolParams
 	LocalstatequeryProtocolParamsGetExecute(
 		r LocalstatequeryAPILocalstatequeryProtocolParamsGetRequest,
-	) (map[string]interface{}, *http.Response, error)
+	) (*ApiResponseLocalStateQueryProtocolParams, *http.Response, error)
 
 	/*
 		LocalstatequerySystemStartGet Query System Start
@@ -412,13 +416,15 @@ type LocalstatequeryAPILocalstatequeryGenesisConfigGetRequest struct {
 	ApiService LocalstatequeryAPI
 }
 
-func (r LocalstatequeryAPILocalstatequeryGenesisConfigGetRequest) Execute() (map[string]interface{}, *http.Response, error) {
+func (r LocalstatequeryAPILocalstatequeryGenesisConfigGetRequest) Execute() (*ApiResponseLocalStateQueryGenesisConfig, *http.Response, error) {
 	return r.ApiService.LocalstatequeryGenesisConfigGetExecute(r)
 }
 
 /*
 LocalstatequeryGenesisConfigGet Query Genesis Config
 
+Query the Shelley genesis config
+
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
 	@return LocalstatequeryAPILocalstatequeryGenesisConfigGetRequest
 */
@@ -433,15 +439,15 @@ func (a *LocalstatequeryAPIService) LocalstatequeryGenesisConfigGet(
 
 // Execute executes the request
 //
-//	@return map[string]interface{}
+//	@return ApiResponseLocalStateQueryGenesisConfig
 func (a *LocalstatequeryAPIService) LocalstatequeryGenesisConfigGetExecute(
 	r LocalstatequeryAPILocalstatequeryGenesisConfigGetRequest,
-) (map[string]interface{}, *http.Response, error) {
+) (*ApiResponseLocalStateQueryGenesisConfig, *http.Response, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
 		formFiles           []formFile
-		localVarReturnValue map[string]interface{}
+		localVarReturnValue *ApiResponseLocalStateQueryGenesisConfig
 	)
 
 	localBasePath, err := a.client.cfg.ServerURLWithContext(
@@ -546,13 +552,15 @@ type LocalstatequeryAPILocalstatequeryProtocolParamsGetRequest struct {
 	ApiService LocalstatequeryAPI
 }
 
-func (r LocalstatequeryAPILocalstatequeryProtocolParamsGetRequest) Execute() (map[string]interface{}, *http.Response, error) {
+func (r LocalstatequeryAPILocalstatequeryProtocolParamsGetRequest) Execute() (*ApiResponseLocalStateQueryProtocolParams, *http.Response, error) {
 	return r.ApiService.LocalstatequeryProtocolParamsGetExecute(r)
 }
 
 /*
 LocalstatequeryProtocolParamsGet Query Current Protocol Parameters
 
+Query the protocol parameters for the current era. Alonzo and later eras are supported, and fields which do not exist in the current era are omitted.
+
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
 	@return LocalstatequeryAPILocalstatequeryProtocolParamsGetRequest
 */
@@ -567,15 +575,15 @@ func (a *LocalstatequeryAPIService) LocalstatequeryProtocolParamsGet(
 
 // Execute executes the request
 //
-//	@return map[string]interface{}
+//	@return ApiResponseLocalStateQueryProtocolParams
 func (a *LocalstatequeryAPIService) LocalstatequeryProtocolParamsGetExecute(
 	r LocalstatequeryAPILocalstatequeryProtocolParamsGetRequest,
-) (map[string]interface{}, *http.Response, error) {
+) (*ApiResponseLocalStateQueryProtocolParams, *http.Response, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
 		formFiles           []formFile
-		localVarReturnValue map[string]interface{}
+		localVarReturnValue *ApiResponseLocalStateQueryProtocolParams
 	)
 
 	localBasePath, err := a.client.cfg.ServerURLWithContext(